@@ -0,0 +1,13 @@
+// Package cachekeys holds the database cache keys that are shared between the web and cron services.
+package cachekeys
+
+import "fmt"
+
+func GuildSettings(guildId string) string {
+	return fmt.Sprintf("guild-settings:%s", guildId)
+}
+
+// The version is bumped whenever something shown on the guild's cards changes, it isn't read through the cache.
+func CardDataVersion(guildId string) string {
+	return fmt.Sprintf("card-data-version:%s", guildId)
+}
@@ -0,0 +1,147 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: api-keys.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+)
+
+const createAPIKey = `-- name: CreateAPIKey :one
+INSERT INTO api_keys (key_id, key_hash, name, scopes, expires_at)
+VALUES ($1, $2, $3, $4::TEXT[], $5)
+RETURNING insert_epoch, key_id, key_hash, name, scopes, expires_at
+`
+
+type CreateAPIKeyParams struct {
+	KeyID     string
+	KeyHash   string
+	Name      string
+	Scopes    []string
+	ExpiresAt sql.NullInt32
+}
+
+func (q *Queries) CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (ApiKey, error) {
+	row := q.db.QueryRowContext(ctx, createAPIKey,
+		arg.KeyID,
+		arg.KeyHash,
+		arg.Name,
+		pq.Array(arg.Scopes),
+		arg.ExpiresAt,
+	)
+	var i ApiKey
+	err := row.Scan(
+		&i.InsertEpoch,
+		&i.KeyID,
+		&i.KeyHash,
+		&i.Name,
+		pq.Array(&i.Scopes),
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const deleteAPIKey = `-- name: DeleteAPIKey :one
+DELETE FROM api_keys
+WHERE
+    key_id = $1
+RETURNING key_hash
+`
+
+func (q *Queries) DeleteAPIKey(ctx context.Context, keyID string) (string, error) {
+	row := q.db.QueryRowContext(ctx, deleteAPIKey, keyID)
+	var key_hash string
+	err := row.Scan(&key_hash)
+	return key_hash, err
+}
+
+const getAPIKeyByHash = `-- name: GetAPIKeyByHash :one
+SELECT insert_epoch, key_id, key_hash, name, scopes, expires_at FROM api_keys
+WHERE
+    key_hash = $1
+LIMIT 1
+`
+
+func (q *Queries) GetAPIKeyByHash(ctx context.Context, keyHash string) (ApiKey, error) {
+	row := q.db.QueryRowContext(ctx, getAPIKeyByHash, keyHash)
+	var i ApiKey
+	err := row.Scan(
+		&i.InsertEpoch,
+		&i.KeyID,
+		&i.KeyHash,
+		&i.Name,
+		pq.Array(&i.Scopes),
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getAPIKeys = `-- name: GetAPIKeys :many
+SELECT insert_epoch, key_id, key_hash, name, scopes, expires_at FROM api_keys
+ORDER BY insert_epoch ASC
+`
+
+func (q *Queries) GetAPIKeys(ctx context.Context) ([]ApiKey, error) {
+	rows, err := q.db.QueryContext(ctx, getAPIKeys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ApiKey
+	for rows.Next() {
+		var i ApiKey
+		if err := rows.Scan(
+			&i.InsertEpoch,
+			&i.KeyID,
+			&i.KeyHash,
+			&i.Name,
+			pq.Array(&i.Scopes),
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateAPIKey = `-- name: UpdateAPIKey :one
+UPDATE api_keys
+SET
+    name = COALESCE($1, name),
+    expires_at = COALESCE($2, expires_at)
+WHERE
+    key_id = $3
+RETURNING insert_epoch, key_id, key_hash, name, scopes, expires_at
+`
+
+type UpdateAPIKeyParams struct {
+	Name      sql.NullString
+	ExpiresAt sql.NullInt32
+	KeyID     string
+}
+
+func (q *Queries) UpdateAPIKey(ctx context.Context, arg UpdateAPIKeyParams) (ApiKey, error) {
+	row := q.db.QueryRowContext(ctx, updateAPIKey, arg.Name, arg.ExpiresAt, arg.KeyID)
+	var i ApiKey
+	err := row.Scan(
+		&i.InsertEpoch,
+		&i.KeyID,
+		&i.KeyHash,
+		&i.Name,
+		pq.Array(&i.Scopes),
+		&i.ExpiresAt,
+	)
+	return i, err
+}
@@ -0,0 +1,60 @@
+package db
+
+import "context"
+
+// GrantActivityPoints grants the points to the member's profile and the current weekly and monthly leaderboards, and records the grant in the ledger.
+// The points are only granted when the cooldown, in seconds, has passed since the last grant, otherwise this returns sql.ErrNoRows.
+// This is shared by the web and cron services, so grants are applied the same way everywhere.
+func GrantActivityPoints(ctx context.Context, q Querier, guildId string, userId string, grantType string, channelId string, points int32, cooldown int32) error {
+	var err error
+	switch grantType {
+	case "voice":
+		_, err = q.IncrementMemberVoiceActivityPoints(ctx, IncrementMemberVoiceActivityPointsParams{
+			GuildID:  guildId,
+			MemberID: userId,
+
+			Points:   points,
+			Cooldown: cooldown,
+		})
+	default:
+		_, err = q.IncrememberMemberChatActivityPoints(ctx, IncrememberMemberChatActivityPointsParams{
+			GuildID:  guildId,
+			MemberID: userId,
+
+			Points:   points,
+			Cooldown: cooldown,
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	err = q.IncrementWeeklyActivityLeaderboard(ctx, IncrementWeeklyActivityLeaderboardParams{
+		GrantType:    grantType,
+		GuildID:      guildId,
+		MemberID:     userId,
+		EarnedPoints: points,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = q.IncrementMonthlyActivityLeaderboard(ctx, IncrementMonthlyActivityLeaderboardParams{
+		GrantType:    grantType,
+		GuildID:      guildId,
+		MemberID:     userId,
+		EarnedPoints: points,
+	})
+	if err != nil {
+		return err
+	}
+
+	return q.InsertActivityLedgerEntry(ctx, InsertActivityLedgerEntryParams{
+		GuildID:   guildId,
+		MemberID:  userId,
+		GrantType: grantType,
+		Source:    "grant",
+		Amount:    points,
+		ChannelID: channelId,
+	})
+}
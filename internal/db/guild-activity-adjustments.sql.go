@@ -0,0 +1,121 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: guild-activity-adjustments.sql
+
+package db
+
+import (
+	"context"
+)
+
+const getMemberActivityPointsForUpdate = `-- name: GetMemberActivityPointsForUpdate :one
+SELECT
+    chat_activity,
+    voice_activity
+FROM guild_profiles
+WHERE
+    guild_id = $1
+    AND member_id = $2
+FOR UPDATE
+`
+
+type GetMemberActivityPointsForUpdateParams struct {
+	GuildID  string
+	MemberID string
+}
+
+type GetMemberActivityPointsForUpdateRow struct {
+	ChatActivity  int32
+	VoiceActivity int32
+}
+
+// Locks the member's profile until the adjustment's transaction is finished.
+func (q *Queries) GetMemberActivityPointsForUpdate(ctx context.Context, arg GetMemberActivityPointsForUpdateParams) (GetMemberActivityPointsForUpdateRow, error) {
+	row := q.db.QueryRowContext(ctx, getMemberActivityPointsForUpdate, arg.GuildID, arg.MemberID)
+	var i GetMemberActivityPointsForUpdateRow
+	err := row.Scan(&i.ChatActivity, &i.VoiceActivity)
+	return i, err
+}
+
+const insertActivityAdjustment = `-- name: InsertActivityAdjustment :one
+INSERT INTO guild_activity_adjustments (
+    guild_id, member_id, grant_type, operation, amount, previous_points, new_points, adjusted_leaderboards, reason, actor_id
+)
+VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+)
+RETURNING insert_epoch, adjustment_id, guild_id, member_id, grant_type, operation, amount, previous_points, new_points, adjusted_leaderboards, reason, actor_id
+`
+
+type InsertActivityAdjustmentParams struct {
+	GuildID              string
+	MemberID             string
+	GrantType            string
+	Operation            string
+	Amount               int32
+	PreviousPoints       int32
+	NewPoints            int32
+	AdjustedLeaderboards bool
+	Reason               string
+	ActorID              string
+}
+
+func (q *Queries) InsertActivityAdjustment(ctx context.Context, arg InsertActivityAdjustmentParams) (GuildActivityAdjustment, error) {
+	row := q.db.QueryRowContext(ctx, insertActivityAdjustment,
+		arg.GuildID,
+		arg.MemberID,
+		arg.GrantType,
+		arg.Operation,
+		arg.Amount,
+		arg.PreviousPoints,
+		arg.NewPoints,
+		arg.AdjustedLeaderboards,
+		arg.Reason,
+		arg.ActorID,
+	)
+	var i GuildActivityAdjustment
+	err := row.Scan(
+		&i.InsertEpoch,
+		&i.AdjustmentID,
+		&i.GuildID,
+		&i.MemberID,
+		&i.GrantType,
+		&i.Operation,
+		&i.Amount,
+		&i.PreviousPoints,
+		&i.NewPoints,
+		&i.AdjustedLeaderboards,
+		&i.Reason,
+		&i.ActorID,
+	)
+	return i, err
+}
+
+const setMemberActivityPoints = `-- name: SetMemberActivityPoints :exec
+UPDATE guild_profiles
+SET
+    chat_activity = CASE WHEN $1::TEXT = 'chat' THEN $2::INT ELSE chat_activity END,
+    voice_activity = CASE WHEN $1::TEXT = 'voice' THEN $2::INT ELSE voice_activity END
+WHERE
+    guild_id = $3
+    AND member_id = $4
+`
+
+type SetMemberActivityPointsParams struct {
+	GrantType string
+	Points    int32
+	GuildID   string
+	MemberID  string
+}
+
+// The last grant is left alone, so adjustments don't affect the member's grant cooldown.
+func (q *Queries) SetMemberActivityPoints(ctx context.Context, arg SetMemberActivityPointsParams) error {
+	_, err := q.db.ExecContext(ctx, setMemberActivityPoints,
+		arg.GrantType,
+		arg.Points,
+		arg.GuildID,
+		arg.MemberID,
+	)
+	return err
+}
@@ -0,0 +1,336 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: guild-activity-boosts.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+)
+
+const createActivityBoost = `-- name: CreateActivityBoost :one
+INSERT INTO guild_activity_boosts (
+    guild_id, name, grant_type, multiplier,
+    role_ids, starts_at, ends_at
+)
+VALUES (
+    $1, $2, $3, $4,
+    $5::TEXT[], $6, $7
+)
+RETURNING insert_epoch, boost_id, guild_id, name, grant_type, multiplier, role_ids, starts_at, ends_at, has_started
+`
+
+type CreateActivityBoostParams struct {
+	GuildID    string
+	Name       string
+	GrantType  string
+	Multiplier float32
+	RoleIds    []string
+	StartsAt   int32
+	EndsAt     int32
+}
+
+func (q *Queries) CreateActivityBoost(ctx context.Context, arg CreateActivityBoostParams) (GuildActivityBoost, error) {
+	row := q.db.QueryRowContext(ctx, createActivityBoost,
+		arg.GuildID,
+		arg.Name,
+		arg.GrantType,
+		arg.Multiplier,
+		pq.Array(arg.RoleIds),
+		arg.StartsAt,
+		arg.EndsAt,
+	)
+	var i GuildActivityBoost
+	err := row.Scan(
+		&i.InsertEpoch,
+		&i.BoostID,
+		&i.GuildID,
+		&i.Name,
+		&i.GrantType,
+		&i.Multiplier,
+		pq.Array(&i.RoleIds),
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.HasStarted,
+	)
+	return i, err
+}
+
+const deleteActivityBoost = `-- name: DeleteActivityBoost :execrows
+DELETE FROM guild_activity_boosts
+WHERE
+    guild_id = $1
+    AND boost_id = $2
+`
+
+type DeleteActivityBoostParams struct {
+	GuildID string
+	BoostID int32
+}
+
+func (q *Queries) DeleteActivityBoost(ctx context.Context, arg DeleteActivityBoostParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteActivityBoost, arg.GuildID, arg.BoostID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteExpiredActivityBoosts = `-- name: DeleteExpiredActivityBoosts :many
+DELETE FROM guild_activity_boosts
+WHERE ends_at <= EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc')
+RETURNING insert_epoch, boost_id, guild_id, name, grant_type, multiplier, role_ids, starts_at, ends_at, has_started
+`
+
+func (q *Queries) DeleteExpiredActivityBoosts(ctx context.Context) ([]GuildActivityBoost, error) {
+	rows, err := q.db.QueryContext(ctx, deleteExpiredActivityBoosts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GuildActivityBoost
+	for rows.Next() {
+		var i GuildActivityBoost
+		if err := rows.Scan(
+			&i.InsertEpoch,
+			&i.BoostID,
+			&i.GuildID,
+			&i.Name,
+			&i.GrantType,
+			&i.Multiplier,
+			pq.Array(&i.RoleIds),
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.HasStarted,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getActiveActivityBoosts = `-- name: GetActiveActivityBoosts :many
+SELECT insert_epoch, boost_id, guild_id, name, grant_type, multiplier, role_ids, starts_at, ends_at, has_started FROM guild_activity_boosts
+WHERE
+    guild_id = $1
+    AND grant_type = $2
+    AND starts_at <= CAST($3 AS INT)
+    AND ends_at > CAST($3 AS INT)
+`
+
+type GetActiveActivityBoostsParams struct {
+	GuildID   string
+	GrantType string
+	At        int32
+}
+
+func (q *Queries) GetActiveActivityBoosts(ctx context.Context, arg GetActiveActivityBoostsParams) ([]GuildActivityBoost, error) {
+	rows, err := q.db.QueryContext(ctx, getActiveActivityBoosts, arg.GuildID, arg.GrantType, arg.At)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GuildActivityBoost
+	for rows.Next() {
+		var i GuildActivityBoost
+		if err := rows.Scan(
+			&i.InsertEpoch,
+			&i.BoostID,
+			&i.GuildID,
+			&i.Name,
+			&i.GrantType,
+			&i.Multiplier,
+			pq.Array(&i.RoleIds),
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.HasStarted,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getActivityBoost = `-- name: GetActivityBoost :one
+SELECT insert_epoch, boost_id, guild_id, name, grant_type, multiplier, role_ids, starts_at, ends_at, has_started FROM guild_activity_boosts
+WHERE
+    guild_id = $1
+    AND boost_id = $2
+`
+
+type GetActivityBoostParams struct {
+	GuildID string
+	BoostID int32
+}
+
+func (q *Queries) GetActivityBoost(ctx context.Context, arg GetActivityBoostParams) (GuildActivityBoost, error) {
+	row := q.db.QueryRowContext(ctx, getActivityBoost, arg.GuildID, arg.BoostID)
+	var i GuildActivityBoost
+	err := row.Scan(
+		&i.InsertEpoch,
+		&i.BoostID,
+		&i.GuildID,
+		&i.Name,
+		&i.GrantType,
+		&i.Multiplier,
+		pq.Array(&i.RoleIds),
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.HasStarted,
+	)
+	return i, err
+}
+
+const getActivityBoosts = `-- name: GetActivityBoosts :many
+SELECT insert_epoch, boost_id, guild_id, name, grant_type, multiplier, role_ids, starts_at, ends_at, has_started FROM guild_activity_boosts
+WHERE
+    guild_id = $1
+    AND ends_at > EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc')
+ORDER BY starts_at ASC, boost_id ASC
+`
+
+// Boosts that have ended are excluded, since they're cleaned up by the cron service.
+func (q *Queries) GetActivityBoosts(ctx context.Context, guildID string) ([]GuildActivityBoost, error) {
+	rows, err := q.db.QueryContext(ctx, getActivityBoosts, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GuildActivityBoost
+	for rows.Next() {
+		var i GuildActivityBoost
+		if err := rows.Scan(
+			&i.InsertEpoch,
+			&i.BoostID,
+			&i.GuildID,
+			&i.Name,
+			&i.GrantType,
+			&i.Multiplier,
+			pq.Array(&i.RoleIds),
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.HasStarted,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const startActivityBoosts = `-- name: StartActivityBoosts :many
+UPDATE guild_activity_boosts
+SET has_started = TRUE
+WHERE
+    NOT has_started
+    AND starts_at <= EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc')
+RETURNING insert_epoch, boost_id, guild_id, name, grant_type, multiplier, role_ids, starts_at, ends_at, has_started
+`
+
+func (q *Queries) StartActivityBoosts(ctx context.Context) ([]GuildActivityBoost, error) {
+	rows, err := q.db.QueryContext(ctx, startActivityBoosts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GuildActivityBoost
+	for rows.Next() {
+		var i GuildActivityBoost
+		if err := rows.Scan(
+			&i.InsertEpoch,
+			&i.BoostID,
+			&i.GuildID,
+			&i.Name,
+			&i.GrantType,
+			&i.Multiplier,
+			pq.Array(&i.RoleIds),
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.HasStarted,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateActivityBoost = `-- name: UpdateActivityBoost :one
+UPDATE guild_activity_boosts
+SET
+    name = COALESCE($1, name),
+    multiplier = COALESCE($2, multiplier),
+    role_ids = COALESCE($3::TEXT[], role_ids),
+    starts_at = COALESCE($4, starts_at),
+    ends_at = COALESCE($5, ends_at)
+WHERE
+    guild_id = $6
+    AND boost_id = $7
+RETURNING insert_epoch, boost_id, guild_id, name, grant_type, multiplier, role_ids, starts_at, ends_at, has_started
+`
+
+type UpdateActivityBoostParams struct {
+	Name       sql.NullString
+	Multiplier sql.NullFloat64
+	RoleIds    []string
+	StartsAt   sql.NullInt32
+	EndsAt     sql.NullInt32
+	GuildID    string
+	BoostID    int32
+}
+
+func (q *Queries) UpdateActivityBoost(ctx context.Context, arg UpdateActivityBoostParams) (GuildActivityBoost, error) {
+	row := q.db.QueryRowContext(ctx, updateActivityBoost,
+		arg.Name,
+		arg.Multiplier,
+		pq.Array(arg.RoleIds),
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.GuildID,
+		arg.BoostID,
+	)
+	var i GuildActivityBoost
+	err := row.Scan(
+		&i.InsertEpoch,
+		&i.BoostID,
+		&i.GuildID,
+		&i.Name,
+		&i.GrantType,
+		&i.Multiplier,
+		pq.Array(&i.RoleIds),
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.HasStarted,
+	)
+	return i, err
+}
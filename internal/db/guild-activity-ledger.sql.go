@@ -0,0 +1,221 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: guild-activity-ledger.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+)
+
+const bulkInsertChatActivityLedgerEntries = `-- name: BulkInsertChatActivityLedgerEntries :exec
+INSERT INTO guild_activity_ledger (insert_epoch, guild_id, member_id, grant_type, source, amount, channel_id)
+SELECT
+    grants.granted_at,
+    grants.guild_id,
+    grants.member_id,
+    'chat',
+    'grant',
+    grants.points,
+    grants.channel_id
+FROM (
+    SELECT
+        UNNEST($1::TEXT[]) AS guild_id,
+        UNNEST($2::TEXT[]) AS member_id,
+        UNNEST($3::INT[]) AS points,
+        UNNEST($4::INT[]) AS granted_at,
+        UNNEST($5::TEXT[]) AS channel_id
+) AS grants
+INNER JOIN guild_profiles
+    ON guild_profiles.guild_id = grants.guild_id
+    AND guild_profiles.member_id = grants.member_id
+`
+
+type BulkInsertChatActivityLedgerEntriesParams struct {
+	GuildIds   []string
+	MemberIds  []string
+	Points     []int32
+	GrantedAt  []int32
+	ChannelIds []string
+}
+
+// Records buffered chat grants, the arrays are matched up by their position.
+// Grants for profiles that don't exist aren't applied, so they aren't recorded either.
+// Each entry is the points a member earned in a single channel.
+func (q *Queries) BulkInsertChatActivityLedgerEntries(ctx context.Context, arg BulkInsertChatActivityLedgerEntriesParams) error {
+	_, err := q.db.ExecContext(ctx, bulkInsertChatActivityLedgerEntries,
+		pq.Array(arg.GuildIds),
+		pq.Array(arg.MemberIds),
+		pq.Array(arg.Points),
+		pq.Array(arg.GrantedAt),
+		pq.Array(arg.ChannelIds),
+	)
+	return err
+}
+
+const compactActivityLedger = `-- name: CompactActivityLedger :execrows
+WITH members AS (
+    SELECT DISTINCT
+        guild_id,
+        member_id,
+        grant_type
+    FROM guild_activity_ledger
+    WHERE
+        insert_epoch < $1::INT
+        AND source <> 'compacted'
+    LIMIT $2::INT
+),
+removed AS (
+    DELETE FROM guild_activity_ledger AS ledger
+    USING members
+    WHERE
+        ledger.guild_id = members.guild_id
+        AND ledger.member_id = members.member_id
+        AND ledger.grant_type = members.grant_type
+        AND ledger.insert_epoch < $1::INT
+    RETURNING
+        ledger.insert_epoch,
+        ledger.guild_id,
+        ledger.member_id,
+        ledger.grant_type,
+        ledger.amount
+)
+INSERT INTO guild_activity_ledger (insert_epoch, guild_id, member_id, grant_type, source, amount)
+SELECT
+    MAX(insert_epoch),
+    guild_id,
+    member_id,
+    grant_type,
+    'compacted',
+    SUM(amount)::INT
+FROM removed
+GROUP BY guild_id, member_id, grant_type
+`
+
+type CompactActivityLedgerParams struct {
+	Before    int32
+	BatchSize int32
+}
+
+// Replaces the entries older than the cutoff with a single compacted entry per member and activity type, which keeps their total.
+// Only a batch of members is compacted at a time, the affected rows are the compacted entries that were inserted.
+func (q *Queries) CompactActivityLedger(ctx context.Context, arg CompactActivityLedgerParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, compactActivityLedger, arg.Before, arg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const countMemberActivityLedger = `-- name: CountMemberActivityLedger :one
+SELECT COUNT(*)::INT
+FROM guild_activity_ledger
+WHERE
+    guild_id = $1
+    AND member_id = $2
+    AND ($3::TEXT IS NULL OR grant_type = $3)
+`
+
+type CountMemberActivityLedgerParams struct {
+	GuildID   string
+	MemberID  string
+	GrantType sql.NullString
+}
+
+func (q *Queries) CountMemberActivityLedger(ctx context.Context, arg CountMemberActivityLedgerParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, countMemberActivityLedger, arg.GuildID, arg.MemberID, arg.GrantType)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const getMemberActivityLedger = `-- name: GetMemberActivityLedger :many
+SELECT insert_epoch, entry_id, guild_id, member_id, grant_type, source, amount, channel_id, reference
+FROM guild_activity_ledger
+WHERE
+    guild_id = $1
+    AND member_id = $2
+    AND ($3::TEXT IS NULL OR grant_type = $3)
+ORDER BY entry_id DESC
+LIMIT $5
+OFFSET $4
+`
+
+type GetMemberActivityLedgerParams struct {
+	GuildID   string
+	MemberID  string
+	GrantType sql.NullString
+	OffsetBy  int32
+	LimitBy   int32
+}
+
+func (q *Queries) GetMemberActivityLedger(ctx context.Context, arg GetMemberActivityLedgerParams) ([]GuildActivityLedger, error) {
+	rows, err := q.db.QueryContext(ctx, getMemberActivityLedger,
+		arg.GuildID,
+		arg.MemberID,
+		arg.GrantType,
+		arg.OffsetBy,
+		arg.LimitBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GuildActivityLedger
+	for rows.Next() {
+		var i GuildActivityLedger
+		if err := rows.Scan(
+			&i.InsertEpoch,
+			&i.EntryID,
+			&i.GuildID,
+			&i.MemberID,
+			&i.GrantType,
+			&i.Source,
+			&i.Amount,
+			&i.ChannelID,
+			&i.Reference,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertActivityLedgerEntry = `-- name: InsertActivityLedgerEntry :exec
+INSERT INTO guild_activity_ledger (guild_id, member_id, grant_type, source, amount, channel_id, reference)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type InsertActivityLedgerEntryParams struct {
+	GuildID   string
+	MemberID  string
+	GrantType string
+	Source    string
+	Amount    int32
+	ChannelID string
+	Reference string
+}
+
+func (q *Queries) InsertActivityLedgerEntry(ctx context.Context, arg InsertActivityLedgerEntryParams) error {
+	_, err := q.db.ExecContext(ctx, insertActivityLedgerEntry,
+		arg.GuildID,
+		arg.MemberID,
+		arg.GrantType,
+		arg.Source,
+		arg.Amount,
+		arg.ChannelID,
+		arg.Reference,
+	)
+	return err
+}
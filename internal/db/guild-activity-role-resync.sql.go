@@ -0,0 +1,242 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: guild-activity-role-resync.sql
+
+package db
+
+import (
+	"context"
+)
+
+const claimActivityRoleResyncJob = `-- name: ClaimActivityRoleResyncJob :one
+UPDATE guild_activity_role_resync_jobs
+SET
+    status = 'running',
+    started_at = COALESCE(started_at, EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc')),
+    locked_until = CAST($1 AS INT)
+WHERE job_id = (
+    SELECT job_id
+    FROM guild_activity_role_resync_jobs AS jobs
+    WHERE
+        jobs.status IN ('pending', 'running')
+        AND (jobs.locked_until IS NULL OR jobs.locked_until < EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc'))
+    ORDER BY jobs.job_id ASC
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING insert_epoch, job_id, guild_id, status, total_profiles, processed_profiles, roles_added, roles_removed, last_member_id, error, started_at, finished_at, locked_until, failed_profiles
+`
+
+// Claims the oldest job that isn't finished or locked by another run.
+func (q *Queries) ClaimActivityRoleResyncJob(ctx context.Context, lockedUntil int32) (GuildActivityRoleResyncJob, error) {
+	row := q.db.QueryRowContext(ctx, claimActivityRoleResyncJob, lockedUntil)
+	var i GuildActivityRoleResyncJob
+	err := row.Scan(
+		&i.InsertEpoch,
+		&i.JobID,
+		&i.GuildID,
+		&i.Status,
+		&i.TotalProfiles,
+		&i.ProcessedProfiles,
+		&i.RolesAdded,
+		&i.RolesRemoved,
+		&i.LastMemberID,
+		&i.Error,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.LockedUntil,
+		&i.FailedProfiles,
+	)
+	return i, err
+}
+
+const createActivityRoleResyncJob = `-- name: CreateActivityRoleResyncJob :one
+INSERT INTO guild_activity_role_resync_jobs (guild_id, total_profiles)
+SELECT
+    $1,
+    COUNT(*)
+FROM guild_profiles
+WHERE guild_profiles.guild_id = $1
+RETURNING insert_epoch, job_id, guild_id, status, total_profiles, processed_profiles, roles_added, roles_removed, last_member_id, error, started_at, finished_at, locked_until, failed_profiles
+`
+
+func (q *Queries) CreateActivityRoleResyncJob(ctx context.Context, guildID string) (GuildActivityRoleResyncJob, error) {
+	row := q.db.QueryRowContext(ctx, createActivityRoleResyncJob, guildID)
+	var i GuildActivityRoleResyncJob
+	err := row.Scan(
+		&i.InsertEpoch,
+		&i.JobID,
+		&i.GuildID,
+		&i.Status,
+		&i.TotalProfiles,
+		&i.ProcessedProfiles,
+		&i.RolesAdded,
+		&i.RolesRemoved,
+		&i.LastMemberID,
+		&i.Error,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.LockedUntil,
+		&i.FailedProfiles,
+	)
+	return i, err
+}
+
+const finishActivityRoleResyncJob = `-- name: FinishActivityRoleResyncJob :exec
+UPDATE guild_activity_role_resync_jobs
+SET
+    status = $1,
+    error = $2,
+    finished_at = EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc'),
+    locked_until = NULL
+WHERE job_id = $3
+`
+
+type FinishActivityRoleResyncJobParams struct {
+	Status string
+	Error  string
+	JobID  int32
+}
+
+func (q *Queries) FinishActivityRoleResyncJob(ctx context.Context, arg FinishActivityRoleResyncJobParams) error {
+	_, err := q.db.ExecContext(ctx, finishActivityRoleResyncJob, arg.Status, arg.Error, arg.JobID)
+	return err
+}
+
+const getActivityRoleResyncBatch = `-- name: GetActivityRoleResyncBatch :many
+SELECT
+    member_id,
+    chat_activity,
+    voice_activity
+FROM guild_profiles
+WHERE
+    guild_id = $1
+    AND member_id > $2
+ORDER BY member_id ASC
+LIMIT $3
+`
+
+type GetActivityRoleResyncBatchParams struct {
+	GuildID       string
+	AfterMemberID string
+	BatchSize     int32
+}
+
+type GetActivityRoleResyncBatchRow struct {
+	MemberID      string
+	ChatActivity  int32
+	VoiceActivity int32
+}
+
+func (q *Queries) GetActivityRoleResyncBatch(ctx context.Context, arg GetActivityRoleResyncBatchParams) ([]GetActivityRoleResyncBatchRow, error) {
+	rows, err := q.db.QueryContext(ctx, getActivityRoleResyncBatch, arg.GuildID, arg.AfterMemberID, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetActivityRoleResyncBatchRow
+	for rows.Next() {
+		var i GetActivityRoleResyncBatchRow
+		if err := rows.Scan(&i.MemberID, &i.ChatActivity, &i.VoiceActivity); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getActivityRoleResyncJob = `-- name: GetActivityRoleResyncJob :one
+SELECT insert_epoch, job_id, guild_id, status, total_profiles, processed_profiles, roles_added, roles_removed, last_member_id, error, started_at, finished_at, locked_until, failed_profiles FROM guild_activity_role_resync_jobs
+WHERE
+    guild_id = $1
+    AND job_id = $2
+`
+
+type GetActivityRoleResyncJobParams struct {
+	GuildID string
+	JobID   int32
+}
+
+func (q *Queries) GetActivityRoleResyncJob(ctx context.Context, arg GetActivityRoleResyncJobParams) (GuildActivityRoleResyncJob, error) {
+	row := q.db.QueryRowContext(ctx, getActivityRoleResyncJob, arg.GuildID, arg.JobID)
+	var i GuildActivityRoleResyncJob
+	err := row.Scan(
+		&i.InsertEpoch,
+		&i.JobID,
+		&i.GuildID,
+		&i.Status,
+		&i.TotalProfiles,
+		&i.ProcessedProfiles,
+		&i.RolesAdded,
+		&i.RolesRemoved,
+		&i.LastMemberID,
+		&i.Error,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.LockedUntil,
+		&i.FailedProfiles,
+	)
+	return i, err
+}
+
+const releaseActivityRoleResyncJob = `-- name: ReleaseActivityRoleResyncJob :exec
+UPDATE guild_activity_role_resync_jobs
+SET locked_until = NULL
+WHERE job_id = $1
+`
+
+func (q *Queries) ReleaseActivityRoleResyncJob(ctx context.Context, jobID int32) error {
+	_, err := q.db.ExecContext(ctx, releaseActivityRoleResyncJob, jobID)
+	return err
+}
+
+const updateActivityRoleResyncJobProgress = `-- name: UpdateActivityRoleResyncJobProgress :execrows
+UPDATE guild_activity_role_resync_jobs
+SET
+    processed_profiles = processed_profiles + $1,
+    failed_profiles = failed_profiles + $2,
+    roles_added = roles_added + $3,
+    roles_removed = roles_removed + $4,
+    last_member_id = $5,
+    locked_until = CAST($6 AS INT)
+WHERE
+    job_id = $7
+    AND last_member_id = $8
+`
+
+type UpdateActivityRoleResyncJobProgressParams struct {
+	Processed        int32
+	Failed           int32
+	RolesAdded       int32
+	RolesRemoved     int32
+	LastMemberID     string
+	LockedUntil      int32
+	JobID            int32
+	PreviousMemberID string
+}
+
+// Also renews the job's lock, so it isn't claimed by another run while this one is still processing it.
+// Nothing is updated when the cursor has moved since the batch was read, which means another run has taken over the job.
+func (q *Queries) UpdateActivityRoleResyncJobProgress(ctx context.Context, arg UpdateActivityRoleResyncJobProgressParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateActivityRoleResyncJobProgress,
+		arg.Processed,
+		arg.Failed,
+		arg.RolesAdded,
+		arg.RolesRemoved,
+		arg.LastMemberID,
+		arg.LockedUntil,
+		arg.JobID,
+		arg.PreviousMemberID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -8,6 +8,8 @@ package db
 import (
 	"context"
 	"database/sql"
+
+	"github.com/lib/pq"
 )
 
 const archiveMonthlyActivityLeaderboard = `-- name: ArchiveMonthlyActivityLeaderboard :exec
@@ -78,6 +80,126 @@ func (q *Queries) ArchiveWeeklyActivityLeaderboard(ctx context.Context) error {
 	return err
 }
 
+const bulkIncrementMonthlyActivityLeaderboard = `-- name: BulkIncrementMonthlyActivityLeaderboard :exec
+INSERT INTO guild_activity_tracking_monthly_current (
+    grant_type, guild_id, member_id, earned_points
+)
+SELECT
+    $1::TEXT,
+    UNNEST($2::TEXT[]),
+    UNNEST($3::TEXT[]),
+    UNNEST($4::INT[])
+ON CONFLICT (grant_type, guild_id, member_id)
+DO UPDATE SET
+    earned_points = guild_activity_tracking_monthly_current.earned_points + EXCLUDED.earned_points
+`
+
+type BulkIncrementMonthlyActivityLeaderboardParams struct {
+	GrantType    string
+	GuildIds     []string
+	MemberIds    []string
+	EarnedPoints []int32
+}
+
+// Applies aggregated grants to the current monthly leaderboard, the arrays are matched up by their position.
+func (q *Queries) BulkIncrementMonthlyActivityLeaderboard(ctx context.Context, arg BulkIncrementMonthlyActivityLeaderboardParams) error {
+	_, err := q.db.ExecContext(ctx, bulkIncrementMonthlyActivityLeaderboard,
+		arg.GrantType,
+		pq.Array(arg.GuildIds),
+		pq.Array(arg.MemberIds),
+		pq.Array(arg.EarnedPoints),
+	)
+	return err
+}
+
+const bulkIncrementWeeklyActivityLeaderboard = `-- name: BulkIncrementWeeklyActivityLeaderboard :exec
+INSERT INTO guild_activity_tracking_weekly_current (
+    grant_type, guild_id, member_id, earned_points
+)
+SELECT
+    $1::TEXT,
+    UNNEST($2::TEXT[]),
+    UNNEST($3::TEXT[]),
+    UNNEST($4::INT[])
+ON CONFLICT (grant_type, guild_id, member_id)
+DO UPDATE SET
+    earned_points = guild_activity_tracking_weekly_current.earned_points + EXCLUDED.earned_points
+`
+
+type BulkIncrementWeeklyActivityLeaderboardParams struct {
+	GrantType    string
+	GuildIds     []string
+	MemberIds    []string
+	EarnedPoints []int32
+}
+
+// Applies aggregated grants to the current weekly leaderboard, the arrays are matched up by their position.
+func (q *Queries) BulkIncrementWeeklyActivityLeaderboard(ctx context.Context, arg BulkIncrementWeeklyActivityLeaderboardParams) error {
+	_, err := q.db.ExecContext(ctx, bulkIncrementWeeklyActivityLeaderboard,
+		arg.GrantType,
+		pq.Array(arg.GuildIds),
+		pq.Array(arg.MemberIds),
+		pq.Array(arg.EarnedPoints),
+	)
+	return err
+}
+
+const deductMonthlyActivityLeaderboard = `-- name: DeductMonthlyActivityLeaderboard :exec
+UPDATE guild_activity_tracking_monthly_current
+SET
+    earned_points = GREATEST(earned_points - $1::INT, 0)
+WHERE
+    grant_type = $2
+    AND guild_id = $3
+    AND member_id = $4
+`
+
+type DeductMonthlyActivityLeaderboardParams struct {
+	Points    int32
+	GrantType string
+	GuildID   string
+	MemberID  string
+}
+
+// Removes points from the member's current monthly leaderboard entry, without going below 0.
+func (q *Queries) DeductMonthlyActivityLeaderboard(ctx context.Context, arg DeductMonthlyActivityLeaderboardParams) error {
+	_, err := q.db.ExecContext(ctx, deductMonthlyActivityLeaderboard,
+		arg.Points,
+		arg.GrantType,
+		arg.GuildID,
+		arg.MemberID,
+	)
+	return err
+}
+
+const deductWeeklyActivityLeaderboard = `-- name: DeductWeeklyActivityLeaderboard :exec
+UPDATE guild_activity_tracking_weekly_current
+SET
+    earned_points = GREATEST(earned_points - $1::INT, 0)
+WHERE
+    grant_type = $2
+    AND guild_id = $3
+    AND member_id = $4
+`
+
+type DeductWeeklyActivityLeaderboardParams struct {
+	Points    int32
+	GrantType string
+	GuildID   string
+	MemberID  string
+}
+
+// Removes points from the member's current weekly leaderboard entry, without going below 0.
+func (q *Queries) DeductWeeklyActivityLeaderboard(ctx context.Context, arg DeductWeeklyActivityLeaderboardParams) error {
+	_, err := q.db.ExecContext(ctx, deductWeeklyActivityLeaderboard,
+		arg.Points,
+		arg.GrantType,
+		arg.GuildID,
+		arg.MemberID,
+	)
+	return err
+}
+
 const flushOudatedMonthlyActivityLeaderboard = `-- name: FlushOudatedMonthlyActivityLeaderboard :exec
 TRUNCATE TABLE guild_activity_tracking_monthly_current
 `
@@ -238,6 +360,467 @@ func (q *Queries) GetAllTimeActivityLeaderboardPages(ctx context.Context, arg Ge
 	return total_pages, err
 }
 
+const getArchivedMonthlyActivityLeaderboard = `-- name: GetArchivedMonthlyActivityLeaderboard :many
+SELECT
+    rankings.rank,
+    rankings.member_id,
+    rankings.earned_points
+FROM (
+    SELECT
+        ROW_NUMBER() OVER (
+            ORDER BY earned_points DESC
+        ) AS rank,
+        member_id,
+        earned_points
+    FROM guild_activity_tracking_monthly
+    WHERE
+        guild_id = $1
+        AND grant_type = $2
+        AND month_start = $3
+) AS rankings
+LIMIT $5
+OFFSET $4
+`
+
+type GetArchivedMonthlyActivityLeaderboardParams struct {
+	GuildID    string
+	GrantType  string
+	MonthStart int32
+	OffsetBy   int32
+	LimitBy    int32
+}
+
+type GetArchivedMonthlyActivityLeaderboardRow struct {
+	Rank         int64
+	MemberID     string
+	EarnedPoints int32
+}
+
+func (q *Queries) GetArchivedMonthlyActivityLeaderboard(ctx context.Context, arg GetArchivedMonthlyActivityLeaderboardParams) ([]GetArchivedMonthlyActivityLeaderboardRow, error) {
+	rows, err := q.db.QueryContext(ctx, getArchivedMonthlyActivityLeaderboard,
+		arg.GuildID,
+		arg.GrantType,
+		arg.MonthStart,
+		arg.OffsetBy,
+		arg.LimitBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetArchivedMonthlyActivityLeaderboardRow
+	for rows.Next() {
+		var i GetArchivedMonthlyActivityLeaderboardRow
+		if err := rows.Scan(&i.Rank, &i.MemberID, &i.EarnedPoints); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getArchivedMonthlyActivityLeaderboardPages = `-- name: GetArchivedMonthlyActivityLeaderboardPages :one
+SELECT
+    CAST(CEIL(COUNT(*)::DECIMAL / $1) AS INT) AS total_pages
+FROM guild_activity_tracking_monthly
+WHERE
+    guild_id = $2
+    AND grant_type = $3
+    AND month_start = $4
+`
+
+type GetArchivedMonthlyActivityLeaderboardPagesParams struct {
+	LimitBy    interface{}
+	GuildID    string
+	GrantType  string
+	MonthStart int32
+}
+
+func (q *Queries) GetArchivedMonthlyActivityLeaderboardPages(ctx context.Context, arg GetArchivedMonthlyActivityLeaderboardPagesParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, getArchivedMonthlyActivityLeaderboardPages,
+		arg.LimitBy,
+		arg.GuildID,
+		arg.GrantType,
+		arg.MonthStart,
+	)
+	var total_pages int32
+	err := row.Scan(&total_pages)
+	return total_pages, err
+}
+
+const getArchivedMonthlyActivityLeaderboardPeriods = `-- name: GetArchivedMonthlyActivityLeaderboardPeriods :many
+SELECT
+    month_start::INT AS period_start,
+    COUNT(*)::INT AS total_members
+FROM guild_activity_tracking_monthly
+WHERE
+    guild_id = $1
+    AND grant_type = $2
+GROUP BY month_start
+ORDER BY month_start DESC
+`
+
+type GetArchivedMonthlyActivityLeaderboardPeriodsParams struct {
+	GuildID   string
+	GrantType string
+}
+
+type GetArchivedMonthlyActivityLeaderboardPeriodsRow struct {
+	PeriodStart  int32
+	TotalMembers int32
+}
+
+func (q *Queries) GetArchivedMonthlyActivityLeaderboardPeriods(ctx context.Context, arg GetArchivedMonthlyActivityLeaderboardPeriodsParams) ([]GetArchivedMonthlyActivityLeaderboardPeriodsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getArchivedMonthlyActivityLeaderboardPeriods, arg.GuildID, arg.GrantType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetArchivedMonthlyActivityLeaderboardPeriodsRow
+	for rows.Next() {
+		var i GetArchivedMonthlyActivityLeaderboardPeriodsRow
+		if err := rows.Scan(&i.PeriodStart, &i.TotalMembers); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getArchivedWeeklyActivityLeaderboard = `-- name: GetArchivedWeeklyActivityLeaderboard :many
+SELECT
+    rankings.rank,
+    rankings.member_id,
+    rankings.earned_points
+FROM (
+    SELECT
+        ROW_NUMBER() OVER (
+            ORDER BY earned_points DESC
+        ) AS rank,
+        member_id,
+        earned_points
+    FROM guild_activity_tracking_weekly
+    WHERE
+        guild_id = $1
+        AND grant_type = $2
+        AND week_start = $3
+) AS rankings
+LIMIT $5
+OFFSET $4
+`
+
+type GetArchivedWeeklyActivityLeaderboardParams struct {
+	GuildID   string
+	GrantType string
+	WeekStart int32
+	OffsetBy  int32
+	LimitBy   int32
+}
+
+type GetArchivedWeeklyActivityLeaderboardRow struct {
+	Rank         int64
+	MemberID     string
+	EarnedPoints int32
+}
+
+func (q *Queries) GetArchivedWeeklyActivityLeaderboard(ctx context.Context, arg GetArchivedWeeklyActivityLeaderboardParams) ([]GetArchivedWeeklyActivityLeaderboardRow, error) {
+	rows, err := q.db.QueryContext(ctx, getArchivedWeeklyActivityLeaderboard,
+		arg.GuildID,
+		arg.GrantType,
+		arg.WeekStart,
+		arg.OffsetBy,
+		arg.LimitBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetArchivedWeeklyActivityLeaderboardRow
+	for rows.Next() {
+		var i GetArchivedWeeklyActivityLeaderboardRow
+		if err := rows.Scan(&i.Rank, &i.MemberID, &i.EarnedPoints); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getArchivedWeeklyActivityLeaderboardPages = `-- name: GetArchivedWeeklyActivityLeaderboardPages :one
+SELECT
+    CAST(CEIL(COUNT(*)::DECIMAL / $1) AS INT) AS total_pages
+FROM guild_activity_tracking_weekly
+WHERE
+    guild_id = $2
+    AND grant_type = $3
+    AND week_start = $4
+`
+
+type GetArchivedWeeklyActivityLeaderboardPagesParams struct {
+	LimitBy   interface{}
+	GuildID   string
+	GrantType string
+	WeekStart int32
+}
+
+func (q *Queries) GetArchivedWeeklyActivityLeaderboardPages(ctx context.Context, arg GetArchivedWeeklyActivityLeaderboardPagesParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, getArchivedWeeklyActivityLeaderboardPages,
+		arg.LimitBy,
+		arg.GuildID,
+		arg.GrantType,
+		arg.WeekStart,
+	)
+	var total_pages int32
+	err := row.Scan(&total_pages)
+	return total_pages, err
+}
+
+const getArchivedWeeklyActivityLeaderboardPeriods = `-- name: GetArchivedWeeklyActivityLeaderboardPeriods :many
+SELECT
+    week_start::INT AS period_start,
+    COUNT(*)::INT AS total_members
+FROM guild_activity_tracking_weekly
+WHERE
+    guild_id = $1
+    AND grant_type = $2
+GROUP BY week_start
+ORDER BY week_start DESC
+`
+
+type GetArchivedWeeklyActivityLeaderboardPeriodsParams struct {
+	GuildID   string
+	GrantType string
+}
+
+type GetArchivedWeeklyActivityLeaderboardPeriodsRow struct {
+	PeriodStart  int32
+	TotalMembers int32
+}
+
+func (q *Queries) GetArchivedWeeklyActivityLeaderboardPeriods(ctx context.Context, arg GetArchivedWeeklyActivityLeaderboardPeriodsParams) ([]GetArchivedWeeklyActivityLeaderboardPeriodsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getArchivedWeeklyActivityLeaderboardPeriods, arg.GuildID, arg.GrantType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetArchivedWeeklyActivityLeaderboardPeriodsRow
+	for rows.Next() {
+		var i GetArchivedWeeklyActivityLeaderboardPeriodsRow
+		if err := rows.Scan(&i.PeriodStart, &i.TotalMembers); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMemberCurrentActivityPeriods = `-- name: GetMemberCurrentActivityPeriods :one
+WITH
+    weekly_leaderboard AS (
+        SELECT
+            member_id,
+            earned_points,
+            ROW_NUMBER() OVER (ORDER BY earned_points DESC)::INT AS rank
+        FROM guild_activity_tracking_weekly_current
+        WHERE
+            guild_activity_tracking_weekly_current.guild_id = $2
+            AND guild_activity_tracking_weekly_current.grant_type = $3
+    ),
+    monthly_leaderboard AS (
+        SELECT
+            member_id,
+            earned_points,
+            ROW_NUMBER() OVER (ORDER BY earned_points DESC)::INT AS rank
+        FROM guild_activity_tracking_monthly_current
+        WHERE
+            guild_activity_tracking_monthly_current.guild_id = $2
+            AND guild_activity_tracking_monthly_current.grant_type = $3
+    )
+SELECT
+    COALESCE(weekly_leaderboard.earned_points, 0)::INT AS weekly_earned_points,
+    COALESCE(weekly_leaderboard.rank, 0)::INT AS weekly_rank,
+    COALESCE(monthly_leaderboard.earned_points, 0)::INT AS monthly_earned_points,
+    COALESCE(monthly_leaderboard.rank, 0)::INT AS monthly_rank
+FROM (SELECT $1::TEXT AS member_id) m
+LEFT JOIN weekly_leaderboard ON weekly_leaderboard.member_id = m.member_id
+LEFT JOIN monthly_leaderboard ON monthly_leaderboard.member_id = m.member_id
+`
+
+type GetMemberCurrentActivityPeriodsParams struct {
+	MemberID  string
+	GuildID   string
+	GrantType string
+}
+
+type GetMemberCurrentActivityPeriodsRow struct {
+	WeeklyEarnedPoints  int32
+	WeeklyRank          int32
+	MonthlyEarnedPoints int32
+	MonthlyRank         int32
+}
+
+func (q *Queries) GetMemberCurrentActivityPeriods(ctx context.Context, arg GetMemberCurrentActivityPeriodsParams) (GetMemberCurrentActivityPeriodsRow, error) {
+	row := q.db.QueryRowContext(ctx, getMemberCurrentActivityPeriods, arg.MemberID, arg.GuildID, arg.GrantType)
+	var i GetMemberCurrentActivityPeriodsRow
+	err := row.Scan(
+		&i.WeeklyEarnedPoints,
+		&i.WeeklyRank,
+		&i.MonthlyEarnedPoints,
+		&i.MonthlyRank,
+	)
+	return i, err
+}
+
+const getMemberMonthlyActivityHistory = `-- name: GetMemberMonthlyActivityHistory :many
+SELECT
+    rankings.month_start,
+    rankings.earned_points,
+    rankings.rank
+FROM (
+    SELECT
+        month_start,
+        member_id,
+        earned_points,
+        ROW_NUMBER() OVER (PARTITION BY month_start ORDER BY earned_points DESC)::INT AS rank
+    FROM guild_activity_tracking_monthly
+    WHERE
+        guild_id = $1
+        AND grant_type = $2
+        AND month_start >= $3::INT
+) AS rankings
+WHERE
+    member_id = $4
+ORDER BY rankings.month_start DESC
+`
+
+type GetMemberMonthlyActivityHistoryParams struct {
+	GuildID   string
+	GrantType string
+	Since     int32
+	MemberID  string
+}
+
+type GetMemberMonthlyActivityHistoryRow struct {
+	MonthStart   int32
+	EarnedPoints int32
+	Rank         int32
+}
+
+func (q *Queries) GetMemberMonthlyActivityHistory(ctx context.Context, arg GetMemberMonthlyActivityHistoryParams) ([]GetMemberMonthlyActivityHistoryRow, error) {
+	rows, err := q.db.QueryContext(ctx, getMemberMonthlyActivityHistory,
+		arg.GuildID,
+		arg.GrantType,
+		arg.Since,
+		arg.MemberID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMemberMonthlyActivityHistoryRow
+	for rows.Next() {
+		var i GetMemberMonthlyActivityHistoryRow
+		if err := rows.Scan(&i.MonthStart, &i.EarnedPoints, &i.Rank); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMemberWeeklyActivityHistory = `-- name: GetMemberWeeklyActivityHistory :many
+SELECT
+    rankings.week_start,
+    rankings.earned_points,
+    rankings.rank
+FROM (
+    SELECT
+        week_start,
+        member_id,
+        earned_points,
+        ROW_NUMBER() OVER (PARTITION BY week_start ORDER BY earned_points DESC)::INT AS rank
+    FROM guild_activity_tracking_weekly
+    WHERE
+        guild_id = $1
+        AND grant_type = $2
+        AND week_start >= $3::INT
+) AS rankings
+WHERE
+    member_id = $4
+ORDER BY rankings.week_start DESC
+`
+
+type GetMemberWeeklyActivityHistoryParams struct {
+	GuildID   string
+	GrantType string
+	Since     int32
+	MemberID  string
+}
+
+type GetMemberWeeklyActivityHistoryRow struct {
+	WeekStart    int32
+	EarnedPoints int32
+	Rank         int32
+}
+
+func (q *Queries) GetMemberWeeklyActivityHistory(ctx context.Context, arg GetMemberWeeklyActivityHistoryParams) ([]GetMemberWeeklyActivityHistoryRow, error) {
+	rows, err := q.db.QueryContext(ctx, getMemberWeeklyActivityHistory,
+		arg.GuildID,
+		arg.GrantType,
+		arg.Since,
+		arg.MemberID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMemberWeeklyActivityHistoryRow
+	for rows.Next() {
+		var i GetMemberWeeklyActivityHistoryRow
+		if err := rows.Scan(&i.WeekStart, &i.EarnedPoints, &i.Rank); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getMonthlyActivityLeaderboard = `-- name: GetMonthlyActivityLeaderboard :many
 SELECT
     rankings.rank,
@@ -0,0 +1,141 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: guild-settings-audit-log.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+)
+
+const countSettingsAuditLog = `-- name: CountSettingsAuditLog :one
+SELECT COUNT(*)::INT
+FROM guild_settings_audit_log
+WHERE guild_id = $1
+`
+
+func (q *Queries) CountSettingsAuditLog(ctx context.Context, guildID string) (int32, error) {
+	row := q.db.QueryRowContext(ctx, countSettingsAuditLog, guildID)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const getGuildAuditLogChannel = `-- name: GetGuildAuditLogChannel :one
+SELECT audit_log_channel_id
+FROM guilds
+WHERE guild_id = $1
+`
+
+func (q *Queries) GetGuildAuditLogChannel(ctx context.Context, guildID string) (string, error) {
+	row := q.db.QueryRowContext(ctx, getGuildAuditLogChannel, guildID)
+	var audit_log_channel_id string
+	err := row.Scan(&audit_log_channel_id)
+	return audit_log_channel_id, err
+}
+
+const getSettingsAuditLog = `-- name: GetSettingsAuditLog :many
+SELECT insert_epoch, entry_id, guild_id, actor_id, action, target, before, after
+FROM guild_settings_audit_log
+WHERE guild_id = $1
+ORDER BY entry_id DESC
+LIMIT $3
+OFFSET $2
+`
+
+type GetSettingsAuditLogParams struct {
+	GuildID  string
+	OffsetBy int32
+	LimitBy  int32
+}
+
+func (q *Queries) GetSettingsAuditLog(ctx context.Context, arg GetSettingsAuditLogParams) ([]GuildSettingsAuditLog, error) {
+	rows, err := q.db.QueryContext(ctx, getSettingsAuditLog, arg.GuildID, arg.OffsetBy, arg.LimitBy)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GuildSettingsAuditLog
+	for rows.Next() {
+		var i GuildSettingsAuditLog
+		if err := rows.Scan(
+			&i.InsertEpoch,
+			&i.EntryID,
+			&i.GuildID,
+			&i.ActorID,
+			&i.Action,
+			&i.Target,
+			&i.Before,
+			&i.After,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertSettingsAuditLogEntry = `-- name: InsertSettingsAuditLogEntry :one
+INSERT INTO guild_settings_audit_log (guild_id, actor_id, action, target, before, after)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING insert_epoch, entry_id, guild_id, actor_id, action, target, before, after
+`
+
+type InsertSettingsAuditLogEntryParams struct {
+	GuildID string
+	ActorID string
+	Action  string
+	Target  string
+	Before  json.RawMessage
+	After   json.RawMessage
+}
+
+func (q *Queries) InsertSettingsAuditLogEntry(ctx context.Context, arg InsertSettingsAuditLogEntryParams) (GuildSettingsAuditLog, error) {
+	row := q.db.QueryRowContext(ctx, insertSettingsAuditLogEntry,
+		arg.GuildID,
+		arg.ActorID,
+		arg.Action,
+		arg.Target,
+		arg.Before,
+		arg.After,
+	)
+	var i GuildSettingsAuditLog
+	err := row.Scan(
+		&i.InsertEpoch,
+		&i.EntryID,
+		&i.GuildID,
+		&i.ActorID,
+		&i.Action,
+		&i.Target,
+		&i.Before,
+		&i.After,
+	)
+	return i, err
+}
+
+const updateGuildAuditLogChannel = `-- name: UpdateGuildAuditLogChannel :one
+UPDATE guilds
+SET audit_log_channel_id = $1
+WHERE guild_id = $2
+RETURNING audit_log_channel_id
+`
+
+type UpdateGuildAuditLogChannelParams struct {
+	AuditLogChannelID string
+	GuildID           string
+}
+
+func (q *Queries) UpdateGuildAuditLogChannel(ctx context.Context, arg UpdateGuildAuditLogChannelParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, updateGuildAuditLogChannel, arg.AuditLogChannelID, arg.GuildID)
+	var audit_log_channel_id string
+	err := row.Scan(&audit_log_channel_id)
+	return audit_log_channel_id, err
+}
@@ -12,6 +12,61 @@ import (
 	"github.com/lib/pq"
 )
 
+const activityRoleThresholdExists = `-- name: ActivityRoleThresholdExists :one
+SELECT EXISTS (
+    SELECT 1
+    FROM guild_activity_roles
+    WHERE
+        guild_id = $1
+        AND grant_type = $2
+        AND required_points = $3::INT
+        AND role_id <> $4
+)
+`
+
+type ActivityRoleThresholdExistsParams struct {
+	GuildID        string
+	GrantType      string
+	RequiredPoints int32
+	RoleID         string
+}
+
+// Checks if another activity role of the same grant type already uses the threshold.
+func (q *Queries) ActivityRoleThresholdExists(ctx context.Context, arg ActivityRoleThresholdExistsParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, activityRoleThresholdExists,
+		arg.GuildID,
+		arg.GrantType,
+		arg.RequiredPoints,
+		arg.RoleID,
+	)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const appendGuildChatActivityDenyRole = `-- name: AppendGuildChatActivityDenyRole :one
+UPDATE guild_chat_activity_settings SET
+    deny_roles = ARRAY(
+        SELECT DISTINCT v
+        FROM UNNEST(ARRAY_APPEND(guild_chat_activity_settings.deny_roles, $1::TEXT)) AS v
+    )
+WHERE
+    guild_id = $2
+RETURNING deny_roles
+`
+
+type AppendGuildChatActivityDenyRoleParams struct {
+	RoleID  string
+	GuildID string
+}
+
+func (q *Queries) AppendGuildChatActivityDenyRole(ctx context.Context, arg AppendGuildChatActivityDenyRoleParams) ([]string, error) {
+	row := q.db.QueryRowContext(ctx, appendGuildChatActivityDenyRole, arg.RoleID, arg.GuildID)
+	var deny_roles []string
+	err := row.Scan(pq.Array(&deny_roles))
+	return deny_roles, err
+}
+
 const appendGuildMessageEmbedSettingsArrays = `-- name: AppendGuildMessageEmbedSettingsArrays :exec
 UPDATE guild_message_embeds_settings SET
     disabled_channels = CASE
@@ -64,7 +119,30 @@ func (q *Queries) AppendGuildMessageEmbedSettingsArrays(ctx context.Context, arg
 	return err
 }
 
-const deleteActivityRole = `-- name: DeleteActivityRole :exec
+const appendGuildVoiceActivityDenyRole = `-- name: AppendGuildVoiceActivityDenyRole :one
+UPDATE guild_voice_activity_settings SET
+    deny_roles = ARRAY(
+        SELECT DISTINCT v
+        FROM UNNEST(ARRAY_APPEND(guild_voice_activity_settings.deny_roles, $1::TEXT)) AS v
+    )
+WHERE
+    guild_id = $2
+RETURNING deny_roles
+`
+
+type AppendGuildVoiceActivityDenyRoleParams struct {
+	RoleID  string
+	GuildID string
+}
+
+func (q *Queries) AppendGuildVoiceActivityDenyRole(ctx context.Context, arg AppendGuildVoiceActivityDenyRoleParams) ([]string, error) {
+	row := q.db.QueryRowContext(ctx, appendGuildVoiceActivityDenyRole, arg.RoleID, arg.GuildID)
+	var deny_roles []string
+	err := row.Scan(pq.Array(&deny_roles))
+	return deny_roles, err
+}
+
+const deleteActivityRole = `-- name: DeleteActivityRole :execrows
 DELETE FROM guild_activity_roles
 WHERE
     guild_id = $1
@@ -76,9 +154,99 @@ type DeleteActivityRoleParams struct {
 	RoleID  string
 }
 
-func (q *Queries) DeleteActivityRole(ctx context.Context, arg DeleteActivityRoleParams) error {
-	_, err := q.db.ExecContext(ctx, deleteActivityRole, arg.GuildID, arg.RoleID)
-	return err
+func (q *Queries) DeleteActivityRole(ctx context.Context, arg DeleteActivityRoleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteActivityRole, arg.GuildID, arg.RoleID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteChatActivityChannelMultiplier = `-- name: DeleteChatActivityChannelMultiplier :execrows
+DELETE FROM guild_chat_activity_channel_multipliers
+WHERE
+    guild_id = $1
+    AND channel_id = $2
+`
+
+type DeleteChatActivityChannelMultiplierParams struct {
+	GuildID   string
+	ChannelID string
+}
+
+func (q *Queries) DeleteChatActivityChannelMultiplier(ctx context.Context, arg DeleteChatActivityChannelMultiplierParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteChatActivityChannelMultiplier, arg.GuildID, arg.ChannelID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getActivityRole = `-- name: GetActivityRole :one
+SELECT
+    role_id,
+    grant_type,
+    required_points
+FROM guild_activity_roles
+WHERE
+    guild_id = $1
+    AND role_id = $2
+`
+
+type GetActivityRoleParams struct {
+	GuildID string
+	RoleID  string
+}
+
+type GetActivityRoleRow struct {
+	RoleID         string
+	GrantType      string
+	RequiredPoints sql.NullInt32
+}
+
+func (q *Queries) GetActivityRole(ctx context.Context, arg GetActivityRoleParams) (GetActivityRoleRow, error) {
+	row := q.db.QueryRowContext(ctx, getActivityRole, arg.GuildID, arg.RoleID)
+	var i GetActivityRoleRow
+	err := row.Scan(&i.RoleID, &i.GrantType, &i.RequiredPoints)
+	return i, err
+}
+
+const getChatActivityChannelMultipliers = `-- name: GetChatActivityChannelMultipliers :many
+SELECT
+    channel_id,
+    multiplier
+FROM guild_chat_activity_channel_multipliers
+WHERE
+    guild_id = $1
+ORDER BY channel_id ASC
+`
+
+type GetChatActivityChannelMultipliersRow struct {
+	ChannelID  string
+	Multiplier float32
+}
+
+func (q *Queries) GetChatActivityChannelMultipliers(ctx context.Context, guildID string) ([]GetChatActivityChannelMultipliersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getChatActivityChannelMultipliers, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetChatActivityChannelMultipliersRow
+	for rows.Next() {
+		var i GetChatActivityChannelMultipliersRow
+		if err := rows.Scan(&i.ChannelID, &i.Multiplier); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const getGuildActivityRoles = `-- name: GetGuildActivityRoles :many
@@ -131,7 +299,10 @@ SELECT
     is_enabled,
     grant_amount,
     grant_cooldown,
-    deny_roles
+    deny_roles,
+    auto_assign_roles,
+    role_assignment_mode,
+    auto_create_profiles
 FROM guild_chat_activity_settings
 WHERE
     guild_chat_activity_settings.guild_id = $1
@@ -139,10 +310,13 @@ LIMIT 1
 `
 
 type GetGuildChatActivitySettingsRow struct {
-	IsEnabled     bool
-	GrantAmount   int32
-	GrantCooldown int32
-	DenyRoles     []string
+	IsEnabled          bool
+	GrantAmount        int32
+	GrantCooldown      int32
+	DenyRoles          []string
+	AutoAssignRoles    bool
+	RoleAssignmentMode string
+	AutoCreateProfiles bool
 }
 
 func (q *Queries) GetGuildChatActivitySettings(ctx context.Context, guildID string) (GetGuildChatActivitySettingsRow, error) {
@@ -153,6 +327,9 @@ func (q *Queries) GetGuildChatActivitySettings(ctx context.Context, guildID stri
 		&i.GrantAmount,
 		&i.GrantCooldown,
 		pq.Array(&i.DenyRoles),
+		&i.AutoAssignRoles,
+		&i.RoleAssignmentMode,
+		&i.AutoCreateProfiles,
 	)
 	return i, err
 }
@@ -193,7 +370,10 @@ SELECT
     is_enabled,
     grant_amount,
     grant_cooldown,
-    deny_roles
+    deny_roles,
+    auto_assign_roles,
+    role_assignment_mode,
+    auto_create_profiles
 FROM guild_voice_activity_settings
 WHERE
     guild_voice_activity_settings.guild_id = $1
@@ -201,10 +381,13 @@ LIMIT 1
 `
 
 type GetGuildVoiceActivitySettingsRow struct {
-	IsEnabled     bool
-	GrantAmount   int32
-	GrantCooldown int32
-	DenyRoles     []string
+	IsEnabled          bool
+	GrantAmount        int32
+	GrantCooldown      int32
+	DenyRoles          []string
+	AutoAssignRoles    bool
+	RoleAssignmentMode string
+	AutoCreateProfiles bool
 }
 
 func (q *Queries) GetGuildVoiceActivitySettings(ctx context.Context, guildID string) (GetGuildVoiceActivitySettingsRow, error) {
@@ -215,6 +398,9 @@ func (q *Queries) GetGuildVoiceActivitySettings(ctx context.Context, guildID str
 		&i.GrantAmount,
 		&i.GrantCooldown,
 		pq.Array(&i.DenyRoles),
+		&i.AutoAssignRoles,
+		&i.RoleAssignmentMode,
+		&i.AutoCreateProfiles,
 	)
 	return i, err
 }
@@ -244,16 +430,36 @@ func (q *Queries) InsertActivityRole(ctx context.Context, arg InsertActivityRole
 const registerGuild = `-- name: RegisterGuild :one
 INSERT INTO guilds (guild_id)
 VALUES ($1)
-RETURNING insert_epoch, guild_id
+RETURNING insert_epoch, guild_id, audit_log_channel_id
 `
 
 func (q *Queries) RegisterGuild(ctx context.Context, guildID string) (Guild, error) {
 	row := q.db.QueryRowContext(ctx, registerGuild, guildID)
 	var i Guild
-	err := row.Scan(&i.InsertEpoch, &i.GuildID)
+	err := row.Scan(&i.InsertEpoch, &i.GuildID, &i.AuditLogChannelID)
 	return i, err
 }
 
+const removeGuildChatActivityDenyRole = `-- name: RemoveGuildChatActivityDenyRole :one
+UPDATE guild_chat_activity_settings SET
+    deny_roles = ARRAY_REMOVE(guild_chat_activity_settings.deny_roles, $1::TEXT)
+WHERE
+    guild_id = $2
+RETURNING deny_roles
+`
+
+type RemoveGuildChatActivityDenyRoleParams struct {
+	RoleID  string
+	GuildID string
+}
+
+func (q *Queries) RemoveGuildChatActivityDenyRole(ctx context.Context, arg RemoveGuildChatActivityDenyRoleParams) ([]string, error) {
+	row := q.db.QueryRowContext(ctx, removeGuildChatActivityDenyRole, arg.RoleID, arg.GuildID)
+	var deny_roles []string
+	err := row.Scan(pq.Array(&deny_roles))
+	return deny_roles, err
+}
+
 const removeGuildMessageEmbedSettingsArrays = `-- name: RemoveGuildMessageEmbedSettingsArrays :exec
 UPDATE guild_message_embeds_settings SET
     disabled_channels = CASE
@@ -297,20 +503,108 @@ func (q *Queries) RemoveGuildMessageEmbedSettingsArrays(ctx context.Context, arg
 	return err
 }
 
+const removeGuildVoiceActivityDenyRole = `-- name: RemoveGuildVoiceActivityDenyRole :one
+UPDATE guild_voice_activity_settings SET
+    deny_roles = ARRAY_REMOVE(guild_voice_activity_settings.deny_roles, $1::TEXT)
+WHERE
+    guild_id = $2
+RETURNING deny_roles
+`
+
+type RemoveGuildVoiceActivityDenyRoleParams struct {
+	RoleID  string
+	GuildID string
+}
+
+func (q *Queries) RemoveGuildVoiceActivityDenyRole(ctx context.Context, arg RemoveGuildVoiceActivityDenyRoleParams) ([]string, error) {
+	row := q.db.QueryRowContext(ctx, removeGuildVoiceActivityDenyRole, arg.RoleID, arg.GuildID)
+	var deny_roles []string
+	err := row.Scan(pq.Array(&deny_roles))
+	return deny_roles, err
+}
+
+const resolveChatActivityChannelMultiplier = `-- name: ResolveChatActivityChannelMultiplier :one
+SELECT multiplier
+FROM guild_chat_activity_channel_multipliers
+WHERE
+    guild_id = $1
+    AND channel_id = ANY($2::TEXT[])
+ORDER BY ARRAY_POSITION($2::TEXT[], channel_id) ASC
+LIMIT 1
+`
+
+type ResolveChatActivityChannelMultiplierParams struct {
+	GuildID    string
+	ChannelIds []string
+}
+
+// The channel IDs should be ordered by priority, for example: the channel, its parent channel and then its category.
+func (q *Queries) ResolveChatActivityChannelMultiplier(ctx context.Context, arg ResolveChatActivityChannelMultiplierParams) (float32, error) {
+	row := q.db.QueryRowContext(ctx, resolveChatActivityChannelMultiplier, arg.GuildID, pq.Array(arg.ChannelIds))
+	var multiplier float32
+	err := row.Scan(&multiplier)
+	return multiplier, err
+}
+
+const updateActivityRole = `-- name: UpdateActivityRole :one
+UPDATE guild_activity_roles
+SET
+    grant_type = COALESCE($1, grant_type),
+    required_points = COALESCE($2, required_points)
+WHERE
+    guild_id = $3
+    AND role_id = $4
+RETURNING
+    role_id,
+    grant_type,
+    required_points
+`
+
+type UpdateActivityRoleParams struct {
+	GrantType      sql.NullString
+	RequiredPoints sql.NullInt32
+	GuildID        string
+	RoleID         string
+}
+
+type UpdateActivityRoleRow struct {
+	RoleID         string
+	GrantType      string
+	RequiredPoints sql.NullInt32
+}
+
+func (q *Queries) UpdateActivityRole(ctx context.Context, arg UpdateActivityRoleParams) (UpdateActivityRoleRow, error) {
+	row := q.db.QueryRowContext(ctx, updateActivityRole,
+		arg.GrantType,
+		arg.RequiredPoints,
+		arg.GuildID,
+		arg.RoleID,
+	)
+	var i UpdateActivityRoleRow
+	err := row.Scan(&i.RoleID, &i.GrantType, &i.RequiredPoints)
+	return i, err
+}
+
 const updateGuildChatActivitySettings = `-- name: UpdateGuildChatActivitySettings :exec
 UPDATE guild_chat_activity_settings SET
     is_enabled = COALESCE($1, guild_chat_activity_settings.is_enabled),
     grant_amount = COALESCE($2, guild_chat_activity_settings.grant_amount),
-    grant_cooldown = COALESCE($3, guild_chat_activity_settings.grant_cooldown)
+    grant_cooldown = COALESCE($3, guild_chat_activity_settings.grant_cooldown),
+    auto_assign_roles = COALESCE($4, guild_chat_activity_settings.auto_assign_roles),
+    role_assignment_mode = COALESCE($5, guild_chat_activity_settings.role_assignment_mode),
+    auto_create_profiles = COALESCE($6, guild_chat_activity_settings.auto_create_profiles)
 WHERE
-    guild_id = $4
+    guild_id = $7
 `
 
 type UpdateGuildChatActivitySettingsParams struct {
-	IsEnabled     sql.NullBool
-	GrantAmount   sql.NullInt32
-	GrantCooldown sql.NullInt32
-	GuildID       string
+	IsEnabled          sql.NullBool
+	GrantAmount        sql.NullInt32
+	GrantCooldown      sql.NullInt32
+	AutoAssignRoles    sql.NullBool
+	RoleAssignmentMode sql.NullString
+	AutoCreateProfiles sql.NullBool
+	GuildID            string
 }
 
 func (q *Queries) UpdateGuildChatActivitySettings(ctx context.Context, arg UpdateGuildChatActivitySettingsParams) error {
@@ -318,6 +612,9 @@ func (q *Queries) UpdateGuildChatActivitySettings(ctx context.Context, arg Updat
 		arg.IsEnabled,
 		arg.GrantAmount,
 		arg.GrantCooldown,
+		arg.AutoAssignRoles,
+		arg.RoleAssignmentMode,
+		arg.AutoCreateProfiles,
 		arg.GuildID,
 	)
 	return err
@@ -344,16 +641,22 @@ const updateGuildVoiceActivitySettings = `-- name: UpdateGuildVoiceActivitySetti
 UPDATE guild_voice_activity_settings SET
     is_enabled = COALESCE($1, guild_voice_activity_settings.is_enabled),
     grant_amount = COALESCE($2, guild_voice_activity_settings.grant_amount),
-    grant_cooldown = COALESCE($3, guild_voice_activity_settings.grant_cooldown)
+    grant_cooldown = COALESCE($3, guild_voice_activity_settings.grant_cooldown),
+    auto_assign_roles = COALESCE($4, guild_voice_activity_settings.auto_assign_roles),
+    role_assignment_mode = COALESCE($5, guild_voice_activity_settings.role_assignment_mode),
+    auto_create_profiles = COALESCE($6, guild_voice_activity_settings.auto_create_profiles)
 WHERE
-    guild_id = $4
+    guild_id = $7
 `
 
 type UpdateGuildVoiceActivitySettingsParams struct {
-	IsEnabled     sql.NullBool
-	GrantAmount   sql.NullInt32
-	GrantCooldown sql.NullInt32
-	GuildID       string
+	IsEnabled          sql.NullBool
+	GrantAmount        sql.NullInt32
+	GrantCooldown      sql.NullInt32
+	AutoAssignRoles    sql.NullBool
+	RoleAssignmentMode sql.NullString
+	AutoCreateProfiles sql.NullBool
+	GuildID            string
 }
 
 func (q *Queries) UpdateGuildVoiceActivitySettings(ctx context.Context, arg UpdateGuildVoiceActivitySettingsParams) error {
@@ -361,7 +664,37 @@ func (q *Queries) UpdateGuildVoiceActivitySettings(ctx context.Context, arg Upda
 		arg.IsEnabled,
 		arg.GrantAmount,
 		arg.GrantCooldown,
+		arg.AutoAssignRoles,
+		arg.RoleAssignmentMode,
+		arg.AutoCreateProfiles,
 		arg.GuildID,
 	)
 	return err
 }
+
+const upsertChatActivityChannelMultiplier = `-- name: UpsertChatActivityChannelMultiplier :one
+INSERT INTO guild_chat_activity_channel_multipliers (guild_id, channel_id, multiplier)
+VALUES ($1, $2, $3)
+ON CONFLICT (guild_id, channel_id)
+DO UPDATE SET
+    multiplier = EXCLUDED.multiplier
+RETURNING channel_id, multiplier
+`
+
+type UpsertChatActivityChannelMultiplierParams struct {
+	GuildID    string
+	ChannelID  string
+	Multiplier float32
+}
+
+type UpsertChatActivityChannelMultiplierRow struct {
+	ChannelID  string
+	Multiplier float32
+}
+
+func (q *Queries) UpsertChatActivityChannelMultiplier(ctx context.Context, arg UpsertChatActivityChannelMultiplierParams) (UpsertChatActivityChannelMultiplierRow, error) {
+	row := q.db.QueryRowContext(ctx, upsertChatActivityChannelMultiplier, arg.GuildID, arg.ChannelID, arg.Multiplier)
+	var i UpsertChatActivityChannelMultiplierRow
+	err := row.Scan(&i.ChannelID, &i.Multiplier)
+	return i, err
+}
@@ -0,0 +1,220 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: guild-voice-sessions.sql
+
+package db
+
+import (
+	"context"
+)
+
+const closeVoiceSession = `-- name: CloseVoiceSession :one
+DELETE FROM guild_voice_sessions
+WHERE
+    guild_id = $1
+    AND member_id = $2
+RETURNING
+    guild_id,
+    member_id,
+    channel_id,
+    started_at,
+    CAST(active_seconds + CASE
+        WHEN is_muted OR is_deafened OR is_alone THEN 0
+        ELSE GREATEST(CAST($3 AS INT) - last_update, 0)
+    END AS INT) AS active_seconds
+`
+
+type CloseVoiceSessionParams struct {
+	GuildID  string
+	MemberID string
+	ClosedAt int32
+}
+
+type CloseVoiceSessionRow struct {
+	GuildID       string
+	MemberID      string
+	ChannelID     string
+	StartedAt     int32
+	ActiveSeconds int32
+}
+
+// The session is only accrued up until `closed_at`.
+// Orphaned sessions are closed at their last update, since anything after it can't be verified.
+func (q *Queries) CloseVoiceSession(ctx context.Context, arg CloseVoiceSessionParams) (CloseVoiceSessionRow, error) {
+	row := q.db.QueryRowContext(ctx, closeVoiceSession, arg.GuildID, arg.MemberID, arg.ClosedAt)
+	var i CloseVoiceSessionRow
+	err := row.Scan(
+		&i.GuildID,
+		&i.MemberID,
+		&i.ChannelID,
+		&i.StartedAt,
+		&i.ActiveSeconds,
+	)
+	return i, err
+}
+
+const getStaleVoiceSessions = `-- name: GetStaleVoiceSessions :many
+SELECT guild_id, member_id, channel_id, started_at, last_update, active_seconds, is_muted, is_deafened, is_alone FROM guild_voice_sessions
+WHERE last_update < CAST($1 AS INT)
+ORDER BY last_update ASC
+`
+
+func (q *Queries) GetStaleVoiceSessions(ctx context.Context, before int32) ([]GuildVoiceSession, error) {
+	rows, err := q.db.QueryContext(ctx, getStaleVoiceSessions, before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GuildVoiceSession
+	for rows.Next() {
+		var i GuildVoiceSession
+		if err := rows.Scan(
+			&i.GuildID,
+			&i.MemberID,
+			&i.ChannelID,
+			&i.StartedAt,
+			&i.LastUpdate,
+			&i.ActiveSeconds,
+			&i.IsMuted,
+			&i.IsDeafened,
+			&i.IsAlone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getVoiceSession = `-- name: GetVoiceSession :one
+SELECT guild_id, member_id, channel_id, started_at, last_update, active_seconds, is_muted, is_deafened, is_alone FROM guild_voice_sessions
+WHERE
+    guild_id = $1
+    AND member_id = $2
+`
+
+type GetVoiceSessionParams struct {
+	GuildID  string
+	MemberID string
+}
+
+func (q *Queries) GetVoiceSession(ctx context.Context, arg GetVoiceSessionParams) (GuildVoiceSession, error) {
+	row := q.db.QueryRowContext(ctx, getVoiceSession, arg.GuildID, arg.MemberID)
+	var i GuildVoiceSession
+	err := row.Scan(
+		&i.GuildID,
+		&i.MemberID,
+		&i.ChannelID,
+		&i.StartedAt,
+		&i.LastUpdate,
+		&i.ActiveSeconds,
+		&i.IsMuted,
+		&i.IsDeafened,
+		&i.IsAlone,
+	)
+	return i, err
+}
+
+const openVoiceSession = `-- name: OpenVoiceSession :one
+INSERT INTO guild_voice_sessions (
+    guild_id, member_id, channel_id,
+    is_muted, is_deafened, is_alone
+)
+VALUES (
+    $1, $2, $3,
+    $4, $5, $6
+)
+RETURNING guild_id, member_id, channel_id, started_at, last_update, active_seconds, is_muted, is_deafened, is_alone
+`
+
+type OpenVoiceSessionParams struct {
+	GuildID    string
+	MemberID   string
+	ChannelID  string
+	IsMuted    bool
+	IsDeafened bool
+	IsAlone    bool
+}
+
+func (q *Queries) OpenVoiceSession(ctx context.Context, arg OpenVoiceSessionParams) (GuildVoiceSession, error) {
+	row := q.db.QueryRowContext(ctx, openVoiceSession,
+		arg.GuildID,
+		arg.MemberID,
+		arg.ChannelID,
+		arg.IsMuted,
+		arg.IsDeafened,
+		arg.IsAlone,
+	)
+	var i GuildVoiceSession
+	err := row.Scan(
+		&i.GuildID,
+		&i.MemberID,
+		&i.ChannelID,
+		&i.StartedAt,
+		&i.LastUpdate,
+		&i.ActiveSeconds,
+		&i.IsMuted,
+		&i.IsDeafened,
+		&i.IsAlone,
+	)
+	return i, err
+}
+
+const updateVoiceSession = `-- name: UpdateVoiceSession :one
+UPDATE guild_voice_sessions
+SET
+    active_seconds = active_seconds + CASE
+        WHEN is_muted OR is_deafened OR is_alone THEN 0
+        ELSE GREATEST(EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc')::INT - last_update, 0)
+    END,
+    last_update = EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc'),
+    channel_id = $1,
+    is_muted = $2,
+    is_deafened = $3,
+    is_alone = $4
+WHERE
+    guild_id = $5
+    AND member_id = $6
+RETURNING guild_id, member_id, channel_id, started_at, last_update, active_seconds, is_muted, is_deafened, is_alone
+`
+
+type UpdateVoiceSessionParams struct {
+	ChannelID  string
+	IsMuted    bool
+	IsDeafened bool
+	IsAlone    bool
+	GuildID    string
+	MemberID   string
+}
+
+// The time since the last update is only accrued if the member was eligible during it.
+func (q *Queries) UpdateVoiceSession(ctx context.Context, arg UpdateVoiceSessionParams) (GuildVoiceSession, error) {
+	row := q.db.QueryRowContext(ctx, updateVoiceSession,
+		arg.ChannelID,
+		arg.IsMuted,
+		arg.IsDeafened,
+		arg.IsAlone,
+		arg.GuildID,
+		arg.MemberID,
+	)
+	var i GuildVoiceSession
+	err := row.Scan(
+		&i.GuildID,
+		&i.MemberID,
+		&i.ChannelID,
+		&i.StartedAt,
+		&i.LastUpdate,
+		&i.ActiveSeconds,
+		&i.IsMuted,
+		&i.IsDeafened,
+		&i.IsAlone,
+	)
+	return i, err
+}
@@ -12,6 +12,41 @@ import (
 	"github.com/lib/pq"
 )
 
+const bulkIncrementMemberChatActivityPoints = `-- name: BulkIncrementMemberChatActivityPoints :exec
+UPDATE guild_profiles
+SET
+    chat_activity = guild_profiles.chat_activity + grants.points,
+    last_chat_activity_grant = GREATEST(guild_profiles.last_chat_activity_grant, grants.granted_at)
+FROM (
+    SELECT
+        UNNEST($1::TEXT[]) AS guild_id,
+        UNNEST($2::TEXT[]) AS member_id,
+        UNNEST($3::INT[]) AS points,
+        UNNEST($4::INT[]) AS granted_at
+) AS grants
+WHERE
+    guild_profiles.guild_id = grants.guild_id
+    AND guild_profiles.member_id = grants.member_id
+`
+
+type BulkIncrementMemberChatActivityPointsParams struct {
+	GuildIds  []string
+	MemberIds []string
+	Points    []int32
+	GrantedAt []int32
+}
+
+// Applies aggregated chat grants to many profiles at once, the arrays are matched up by their position.
+func (q *Queries) BulkIncrementMemberChatActivityPoints(ctx context.Context, arg BulkIncrementMemberChatActivityPointsParams) error {
+	_, err := q.db.ExecContext(ctx, bulkIncrementMemberChatActivityPoints,
+		pq.Array(arg.GuildIds),
+		pq.Array(arg.MemberIds),
+		pq.Array(arg.Points),
+		pq.Array(arg.GrantedAt),
+	)
+	return err
+}
+
 const createMemberProfile = `-- name: CreateMemberProfile :one
 INSERT INTO guild_profiles (guild_id, member_id)
     VALUES ($1, $2)
@@ -39,26 +74,48 @@ func (q *Queries) CreateMemberProfile(ctx context.Context, arg CreateMemberProfi
 	return i, err
 }
 
-const getMemberChatActivityRoleInfo = `-- name: GetMemberChatActivityRoleInfo :one
+const ensureMemberProfile = `-- name: EnsureMemberProfile :execrows
+INSERT INTO guild_profiles (guild_id, member_id)
+    VALUES ($1, $2)
+ON CONFLICT (guild_id, member_id) DO NOTHING
+`
+
+type EnsureMemberProfileParams struct {
+	GuildID  string
+	MemberID string
+}
+
+// Creates the member's profile when it doesn't exist yet, a row is only affected when it was created.
+func (q *Queries) EnsureMemberProfile(ctx context.Context, arg EnsureMemberProfileParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, ensureMemberProfile, arg.GuildID, arg.MemberID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getMemberActivityRoleInfo = `-- name: GetMemberActivityRoleInfo :one
 WITH
     activity_roles AS (
         SELECT
             role_id,
             required_points
         FROM guild_activity_roles
-        WHERE guild_activity_roles.guild_id = $1
+        WHERE
+            guild_activity_roles.guild_id = $1
+            AND guild_activity_roles.grant_type = $2
     ),
     all_role_ids AS (
         SELECT CAST(ARRAY_AGG(role_id) AS TEXT[]) AS role_ids
         FROM activity_roles
-        WHERE required_points <= CAST($2 AS INT)
+        WHERE required_points <= CAST($3 AS INT)
     ),
     current_role_info AS (
         SELECT
             role_id,
             required_points
         FROM activity_roles
-        WHERE activity_roles.required_points <= CAST($2 AS INT)
+        WHERE activity_roles.required_points <= CAST($3 AS INT)
         ORDER BY required_points DESC
         LIMIT 1
     ),
@@ -67,7 +124,7 @@ WITH
             role_id,
             required_points
         FROM activity_roles
-        WHERE activity_roles.required_points > CAST($2 AS INT)
+        WHERE activity_roles.required_points > CAST($3 AS INT)
         ORDER BY required_points ASC
         LIMIT 1
     )
@@ -84,12 +141,13 @@ FULL OUTER JOIN next_role_info ON TRUE
 CROSS JOIN all_role_ids
 `
 
-type GetMemberChatActivityRoleInfoParams struct {
-	GuildID string
-	Points  int32
+type GetMemberActivityRoleInfoParams struct {
+	GuildID   string
+	GrantType string
+	Points    int32
 }
 
-type GetMemberChatActivityRoleInfoRow struct {
+type GetMemberActivityRoleInfoRow struct {
 	CurrentRolesIds           []string
 	CurrentRoleID             sql.NullString
 	CurrentRoleRequiredPoints sql.NullInt32
@@ -97,9 +155,9 @@ type GetMemberChatActivityRoleInfoRow struct {
 	NextRoleRequiredPoints    sql.NullInt32
 }
 
-func (q *Queries) GetMemberChatActivityRoleInfo(ctx context.Context, arg GetMemberChatActivityRoleInfoParams) (GetMemberChatActivityRoleInfoRow, error) {
-	row := q.db.QueryRowContext(ctx, getMemberChatActivityRoleInfo, arg.GuildID, arg.Points)
-	var i GetMemberChatActivityRoleInfoRow
+func (q *Queries) GetMemberActivityRoleInfo(ctx context.Context, arg GetMemberActivityRoleInfoParams) (GetMemberActivityRoleInfoRow, error) {
+	row := q.db.QueryRowContext(ctx, getMemberActivityRoleInfo, arg.GuildID, arg.GrantType, arg.Points)
+	var i GetMemberActivityRoleInfoRow
 	err := row.Scan(
 		pq.Array(&i.CurrentRolesIds),
 		&i.CurrentRoleID,
@@ -175,6 +233,7 @@ SET
 WHERE
     guild_id = $2
     AND member_id = $3
+    AND last_chat_activity_grant <= EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc') - $4::INT
 RETURNING insert_epoch, guild_id, member_id, card_style, chat_activity, last_chat_activity_grant, voice_activity, last_voice_activity_grant
 `
 
@@ -182,10 +241,59 @@ type IncrememberMemberChatActivityPointsParams struct {
 	Points   int32
 	GuildID  string
 	MemberID string
+	Cooldown int32
 }
 
+// The points are only granted once the cooldown has passed since the last grant.
+// Concurrent grants wait on the row lock and re-check the cooldown, so only one of them is applied and the rest return no rows.
 func (q *Queries) IncrememberMemberChatActivityPoints(ctx context.Context, arg IncrememberMemberChatActivityPointsParams) (GuildProfile, error) {
-	row := q.db.QueryRowContext(ctx, incrememberMemberChatActivityPoints, arg.Points, arg.GuildID, arg.MemberID)
+	row := q.db.QueryRowContext(ctx, incrememberMemberChatActivityPoints,
+		arg.Points,
+		arg.GuildID,
+		arg.MemberID,
+		arg.Cooldown,
+	)
+	var i GuildProfile
+	err := row.Scan(
+		&i.InsertEpoch,
+		&i.GuildID,
+		&i.MemberID,
+		&i.CardStyle,
+		&i.ChatActivity,
+		&i.LastChatActivityGrant,
+		&i.VoiceActivity,
+		&i.LastVoiceActivityGrant,
+	)
+	return i, err
+}
+
+const incrementMemberVoiceActivityPoints = `-- name: IncrementMemberVoiceActivityPoints :one
+UPDATE guild_profiles
+SET
+    voice_activity = voice_activity + $1,
+    last_voice_activity_grant = EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc')
+WHERE
+    guild_id = $2
+    AND member_id = $3
+    AND last_voice_activity_grant <= EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc') - $4::INT
+RETURNING insert_epoch, guild_id, member_id, card_style, chat_activity, last_chat_activity_grant, voice_activity, last_voice_activity_grant
+`
+
+type IncrementMemberVoiceActivityPointsParams struct {
+	Points   int32
+	GuildID  string
+	MemberID string
+	Cooldown int32
+}
+
+// The cooldown works the same way as chat activity grants, a cooldown of 0 always grants the points.
+func (q *Queries) IncrementMemberVoiceActivityPoints(ctx context.Context, arg IncrementMemberVoiceActivityPointsParams) (GuildProfile, error) {
+	row := q.db.QueryRowContext(ctx, incrementMemberVoiceActivityPoints,
+		arg.Points,
+		arg.GuildID,
+		arg.MemberID,
+		arg.Cooldown,
+	)
 	var i GuildProfile
 	err := row.Scan(
 		&i.InsertEpoch,
@@ -6,11 +6,22 @@ package db
 
 import (
 	"database/sql"
+	"encoding/json"
 )
 
+type ApiKey struct {
+	InsertEpoch int32
+	KeyID       string
+	KeyHash     string
+	Name        string
+	Scopes      []string
+	ExpiresAt   sql.NullInt32
+}
+
 type Guild struct {
-	InsertEpoch sql.NullInt32
-	GuildID     string
+	InsertEpoch       sql.NullInt32
+	GuildID           string
+	AuditLogChannelID string
 }
 
 type GuildActiveVoiceRoom struct {
@@ -23,6 +34,46 @@ type GuildActiveVoiceRoom struct {
 	IsLocked        sql.NullBool
 }
 
+type GuildActivityAdjustment struct {
+	InsertEpoch          int32
+	AdjustmentID         int32
+	GuildID              string
+	MemberID             string
+	GrantType            string
+	Operation            string
+	Amount               int32
+	PreviousPoints       int32
+	NewPoints            int32
+	AdjustedLeaderboards bool
+	Reason               string
+	ActorID              string
+}
+
+type GuildActivityBoost struct {
+	InsertEpoch sql.NullInt32
+	BoostID     int32
+	GuildID     string
+	Name        string
+	GrantType   string
+	Multiplier  float32
+	RoleIds     []string
+	StartsAt    int32
+	EndsAt      int32
+	HasStarted  bool
+}
+
+type GuildActivityLedger struct {
+	InsertEpoch int32
+	EntryID     int64
+	GuildID     string
+	MemberID    string
+	GrantType   string
+	Source      string
+	Amount      int32
+	ChannelID   string
+	Reference   string
+}
+
 type GuildActivityRole struct {
 	InsertEpoch    sql.NullInt32
 	GuildID        string
@@ -31,6 +82,23 @@ type GuildActivityRole struct {
 	GrantType      string
 }
 
+type GuildActivityRoleResyncJob struct {
+	InsertEpoch       int32
+	JobID             int32
+	GuildID           string
+	Status            string
+	TotalProfiles     int32
+	ProcessedProfiles int32
+	RolesAdded        int32
+	RolesRemoved      int32
+	LastMemberID      string
+	Error             string
+	StartedAt         sql.NullInt32
+	FinishedAt        sql.NullInt32
+	LockedUntil       sql.NullInt32
+	FailedProfiles    int32
+}
+
 type GuildActivityTrackingMonthly struct {
 	MonthStart   int32
 	GuildID      string
@@ -61,12 +129,21 @@ type GuildActivityTrackingWeeklyCurrent struct {
 	EarnedPoints int32
 }
 
+type GuildChatActivityChannelMultiplier struct {
+	GuildID    string
+	ChannelID  string
+	Multiplier float32
+}
+
 type GuildChatActivitySetting struct {
-	GuildID       string
-	IsEnabled     bool
-	GrantAmount   int32
-	GrantCooldown int32
-	DenyRoles     []string
+	GuildID            string
+	IsEnabled          bool
+	GrantAmount        int32
+	GrantCooldown      int32
+	DenyRoles          []string
+	AutoAssignRoles    bool
+	RoleAssignmentMode string
+	AutoCreateProfiles bool
 }
 
 type GuildMessageEmbedsSetting struct {
@@ -88,12 +165,26 @@ type GuildProfile struct {
 	LastVoiceActivityGrant int32
 }
 
+type GuildSettingsAuditLog struct {
+	InsertEpoch int32
+	EntryID     int64
+	GuildID     string
+	ActorID     string
+	Action      string
+	Target      string
+	Before      json.RawMessage
+	After       json.RawMessage
+}
+
 type GuildVoiceActivitySetting struct {
-	GuildID       string
-	IsEnabled     bool
-	GrantAmount   int32
-	GrantCooldown int32
-	DenyRoles     []string
+	GuildID            string
+	IsEnabled          bool
+	GrantAmount        int32
+	GrantCooldown      int32
+	DenyRoles          []string
+	AutoAssignRoles    bool
+	RoleAssignmentMode string
+	AutoCreateProfiles bool
 }
 
 type GuildVoiceRoomsSetting struct {
@@ -105,3 +196,15 @@ type GuildVoiceRoomsSetting struct {
 	CanLock        bool
 	CanAdjustLimit bool
 }
+
+type GuildVoiceSession struct {
+	GuildID       string
+	MemberID      string
+	ChannelID     string
+	StartedAt     int32
+	LastUpdate    int32
+	ActiveSeconds int32
+	IsMuted       bool
+	IsDeafened    bool
+	IsAlone       bool
+}
@@ -9,50 +9,140 @@ import (
 )
 
 type Querier interface {
+	// Checks if another activity role of the same grant type already uses the threshold.
+	ActivityRoleThresholdExists(ctx context.Context, arg ActivityRoleThresholdExistsParams) (bool, error)
+	AppendGuildChatActivityDenyRole(ctx context.Context, arg AppendGuildChatActivityDenyRoleParams) ([]string, error)
 	AppendGuildMessageEmbedSettingsArrays(ctx context.Context, arg AppendGuildMessageEmbedSettingsArraysParams) error
+	AppendGuildVoiceActivityDenyRole(ctx context.Context, arg AppendGuildVoiceActivityDenyRoleParams) ([]string, error)
 	ArchiveMonthlyActivityLeaderboard(ctx context.Context) error
 	ArchiveWeeklyActivityLeaderboard(ctx context.Context) error
+	// Applies aggregated chat grants to many profiles at once, the arrays are matched up by their position.
+	BulkIncrementMemberChatActivityPoints(ctx context.Context, arg BulkIncrementMemberChatActivityPointsParams) error
+	// Applies aggregated grants to the current monthly leaderboard, the arrays are matched up by their position.
+	BulkIncrementMonthlyActivityLeaderboard(ctx context.Context, arg BulkIncrementMonthlyActivityLeaderboardParams) error
+	// Applies aggregated grants to the current weekly leaderboard, the arrays are matched up by their position.
+	BulkIncrementWeeklyActivityLeaderboard(ctx context.Context, arg BulkIncrementWeeklyActivityLeaderboardParams) error
+	// Records buffered chat grants, the arrays are matched up by their position.
+	// Grants for profiles that don't exist aren't applied, so they aren't recorded either.
+	// Each entry is the points a member earned in a single channel.
+	BulkInsertChatActivityLedgerEntries(ctx context.Context, arg BulkInsertChatActivityLedgerEntriesParams) error
+	// Claims the oldest job that isn't finished or locked by another run.
+	ClaimActivityRoleResyncJob(ctx context.Context, lockedUntil int32) (GuildActivityRoleResyncJob, error)
+	// The session is only accrued up until `closed_at`.
+	// Orphaned sessions are closed at their last update, since anything after it can't be verified.
+	CloseVoiceSession(ctx context.Context, arg CloseVoiceSessionParams) (CloseVoiceSessionRow, error)
+	// Replaces the entries older than the cutoff with a single compacted entry per member and activity type, which keeps their total.
+	// Only a batch of members is compacted at a time, the affected rows are the compacted entries that were inserted.
+	CompactActivityLedger(ctx context.Context, arg CompactActivityLedgerParams) (int64, error)
+	CountMemberActivityLedger(ctx context.Context, arg CountMemberActivityLedgerParams) (int32, error)
+	CountSettingsAuditLog(ctx context.Context, guildID string) (int32, error)
+	CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (ApiKey, error)
+	CreateActivityBoost(ctx context.Context, arg CreateActivityBoostParams) (GuildActivityBoost, error)
+	CreateActivityRoleResyncJob(ctx context.Context, guildID string) (GuildActivityRoleResyncJob, error)
 	CreateMemberProfile(ctx context.Context, arg CreateMemberProfileParams) (GuildProfile, error)
 	CreateVoiceRoomLobby(ctx context.Context, arg CreateVoiceRoomLobbyParams) (GuildVoiceRoomsSetting, error)
-	DeleteActivityRole(ctx context.Context, arg DeleteActivityRoleParams) error
+	// Removes points from the member's current monthly leaderboard entry, without going below 0.
+	DeductMonthlyActivityLeaderboard(ctx context.Context, arg DeductMonthlyActivityLeaderboardParams) error
+	// Removes points from the member's current weekly leaderboard entry, without going below 0.
+	DeductWeeklyActivityLeaderboard(ctx context.Context, arg DeductWeeklyActivityLeaderboardParams) error
+	DeleteAPIKey(ctx context.Context, keyID string) (string, error)
+	DeleteActivityBoost(ctx context.Context, arg DeleteActivityBoostParams) (int64, error)
+	DeleteActivityRole(ctx context.Context, arg DeleteActivityRoleParams) (int64, error)
+	DeleteChatActivityChannelMultiplier(ctx context.Context, arg DeleteChatActivityChannelMultiplierParams) (int64, error)
+	DeleteExpiredActivityBoosts(ctx context.Context) ([]GuildActivityBoost, error)
 	DeleteVoiceRoom(ctx context.Context, arg DeleteVoiceRoomParams) error
 	DeleteVoiceRoomLobby(ctx context.Context, arg DeleteVoiceRoomLobbyParams) error
+	// Creates the member's profile when it doesn't exist yet, a row is only affected when it was created.
+	EnsureMemberProfile(ctx context.Context, arg EnsureMemberProfileParams) (int64, error)
+	FinishActivityRoleResyncJob(ctx context.Context, arg FinishActivityRoleResyncJobParams) error
 	FlushOudatedMonthlyActivityLeaderboard(ctx context.Context) error
 	FlushOudatedWeeklyActivityLeaderboard(ctx context.Context) error
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (ApiKey, error)
+	GetAPIKeys(ctx context.Context) ([]ApiKey, error)
+	GetActiveActivityBoosts(ctx context.Context, arg GetActiveActivityBoostsParams) ([]GuildActivityBoost, error)
+	GetActivityBoost(ctx context.Context, arg GetActivityBoostParams) (GuildActivityBoost, error)
+	// Boosts that have ended are excluded, since they're cleaned up by the cron service.
+	GetActivityBoosts(ctx context.Context, guildID string) ([]GuildActivityBoost, error)
 	GetActivityLeaderboardRankings(ctx context.Context, arg GetActivityLeaderboardRankingsParams) (GetActivityLeaderboardRankingsRow, error)
+	GetActivityRole(ctx context.Context, arg GetActivityRoleParams) (GetActivityRoleRow, error)
+	GetActivityRoleResyncBatch(ctx context.Context, arg GetActivityRoleResyncBatchParams) ([]GetActivityRoleResyncBatchRow, error)
+	GetActivityRoleResyncJob(ctx context.Context, arg GetActivityRoleResyncJobParams) (GuildActivityRoleResyncJob, error)
 	GetAllTimeActivityLeaderboard(ctx context.Context, arg GetAllTimeActivityLeaderboardParams) ([]GetAllTimeActivityLeaderboardRow, error)
 	GetAllTimeActivityLeaderboardPages(ctx context.Context, arg GetAllTimeActivityLeaderboardPagesParams) (int32, error)
+	GetArchivedMonthlyActivityLeaderboard(ctx context.Context, arg GetArchivedMonthlyActivityLeaderboardParams) ([]GetArchivedMonthlyActivityLeaderboardRow, error)
+	GetArchivedMonthlyActivityLeaderboardPages(ctx context.Context, arg GetArchivedMonthlyActivityLeaderboardPagesParams) (int32, error)
+	GetArchivedMonthlyActivityLeaderboardPeriods(ctx context.Context, arg GetArchivedMonthlyActivityLeaderboardPeriodsParams) ([]GetArchivedMonthlyActivityLeaderboardPeriodsRow, error)
+	GetArchivedWeeklyActivityLeaderboard(ctx context.Context, arg GetArchivedWeeklyActivityLeaderboardParams) ([]GetArchivedWeeklyActivityLeaderboardRow, error)
+	GetArchivedWeeklyActivityLeaderboardPages(ctx context.Context, arg GetArchivedWeeklyActivityLeaderboardPagesParams) (int32, error)
+	GetArchivedWeeklyActivityLeaderboardPeriods(ctx context.Context, arg GetArchivedWeeklyActivityLeaderboardPeriodsParams) ([]GetArchivedWeeklyActivityLeaderboardPeriodsRow, error)
+	GetChatActivityChannelMultipliers(ctx context.Context, guildID string) ([]GetChatActivityChannelMultipliersRow, error)
 	GetGuildActivityRoles(ctx context.Context, arg GetGuildActivityRolesParams) ([]GetGuildActivityRolesRow, error)
+	GetGuildAuditLogChannel(ctx context.Context, guildID string) (string, error)
 	GetGuildChatActivitySettings(ctx context.Context, guildID string) (GetGuildChatActivitySettingsRow, error)
 	GetGuildMessageEmbedSettings(ctx context.Context, guildID string) (GetGuildMessageEmbedSettingsRow, error)
 	GetGuildVoiceActivitySettings(ctx context.Context, guildID string) (GetGuildVoiceActivitySettingsRow, error)
-	GetMemberChatActivityRoleInfo(ctx context.Context, arg GetMemberChatActivityRoleInfoParams) (GetMemberChatActivityRoleInfoRow, error)
+	GetMemberActivityLedger(ctx context.Context, arg GetMemberActivityLedgerParams) ([]GuildActivityLedger, error)
+	// Locks the member's profile until the adjustment's transaction is finished.
+	GetMemberActivityPointsForUpdate(ctx context.Context, arg GetMemberActivityPointsForUpdateParams) (GetMemberActivityPointsForUpdateRow, error)
+	GetMemberActivityRoleInfo(ctx context.Context, arg GetMemberActivityRoleInfoParams) (GetMemberActivityRoleInfoRow, error)
+	GetMemberCurrentActivityPeriods(ctx context.Context, arg GetMemberCurrentActivityPeriodsParams) (GetMemberCurrentActivityPeriodsRow, error)
+	GetMemberMonthlyActivityHistory(ctx context.Context, arg GetMemberMonthlyActivityHistoryParams) ([]GetMemberMonthlyActivityHistoryRow, error)
 	GetMemberProfile(ctx context.Context, arg GetMemberProfileParams) (GetMemberProfileRow, error)
+	GetMemberWeeklyActivityHistory(ctx context.Context, arg GetMemberWeeklyActivityHistoryParams) ([]GetMemberWeeklyActivityHistoryRow, error)
 	GetMonthlyActivityLeaderboard(ctx context.Context, arg GetMonthlyActivityLeaderboardParams) ([]GetMonthlyActivityLeaderboardRow, error)
 	GetMonthlyActivityLeaderboardPages(ctx context.Context, arg GetMonthlyActivityLeaderboardPagesParams) (int32, error)
 	GetMonthlyActivityLeaderboardResetDetails(ctx context.Context) (GetMonthlyActivityLeaderboardResetDetailsRow, error)
+	GetSettingsAuditLog(ctx context.Context, arg GetSettingsAuditLogParams) ([]GuildSettingsAuditLog, error)
+	GetStaleVoiceSessions(ctx context.Context, before int32) ([]GuildVoiceSession, error)
 	GetVoiceRoom(ctx context.Context, arg GetVoiceRoomParams) (GuildActiveVoiceRoom, error)
 	GetVoiceRoomIds(ctx context.Context, arg GetVoiceRoomIdsParams) ([]string, error)
 	GetVoiceRoomLobbies(ctx context.Context, guildID string) ([]GetVoiceRoomLobbiesRow, error)
 	GetVoiceRoomLobby(ctx context.Context, arg GetVoiceRoomLobbyParams) (GuildVoiceRoomsSetting, error)
 	GetVoiceRooms(ctx context.Context, arg GetVoiceRoomsParams) ([]GuildActiveVoiceRoom, error)
+	GetVoiceSession(ctx context.Context, arg GetVoiceSessionParams) (GuildVoiceSession, error)
 	GetWeeklyActivityLeaderboard(ctx context.Context, arg GetWeeklyActivityLeaderboardParams) ([]GetWeeklyActivityLeaderboardRow, error)
 	GetWeeklyActivityLeaderboardPages(ctx context.Context, arg GetWeeklyActivityLeaderboardPagesParams) (int32, error)
 	GetWeeklyActivityLeaderboardResetDetails(ctx context.Context) (GetWeeklyActivityLeaderboardResetDetailsRow, error)
+	// The points are only granted once the cooldown has passed since the last grant.
+	// Concurrent grants wait on the row lock and re-check the cooldown, so only one of them is applied and the rest return no rows.
 	IncrememberMemberChatActivityPoints(ctx context.Context, arg IncrememberMemberChatActivityPointsParams) (GuildProfile, error)
+	// The cooldown works the same way as chat activity grants, a cooldown of 0 always grants the points.
+	IncrementMemberVoiceActivityPoints(ctx context.Context, arg IncrementMemberVoiceActivityPointsParams) (GuildProfile, error)
 	IncrementMonthlyActivityLeaderboard(ctx context.Context, arg IncrementMonthlyActivityLeaderboardParams) error
 	IncrementWeeklyActivityLeaderboard(ctx context.Context, arg IncrementWeeklyActivityLeaderboardParams) error
+	InsertActivityAdjustment(ctx context.Context, arg InsertActivityAdjustmentParams) (GuildActivityAdjustment, error)
+	InsertActivityLedgerEntry(ctx context.Context, arg InsertActivityLedgerEntryParams) error
 	InsertActivityRole(ctx context.Context, arg InsertActivityRoleParams) error
+	InsertSettingsAuditLogEntry(ctx context.Context, arg InsertSettingsAuditLogEntryParams) (GuildSettingsAuditLog, error)
 	MigrateMemberProfile(ctx context.Context, arg MigrateMemberProfileParams) error
+	OpenVoiceSession(ctx context.Context, arg OpenVoiceSessionParams) (GuildVoiceSession, error)
 	RegisterGuild(ctx context.Context, guildID string) (Guild, error)
 	RegisterVoiceRoom(ctx context.Context, arg RegisterVoiceRoomParams) (GuildActiveVoiceRoom, error)
+	ReleaseActivityRoleResyncJob(ctx context.Context, jobID int32) error
+	RemoveGuildChatActivityDenyRole(ctx context.Context, arg RemoveGuildChatActivityDenyRoleParams) ([]string, error)
 	RemoveGuildMessageEmbedSettingsArrays(ctx context.Context, arg RemoveGuildMessageEmbedSettingsArraysParams) error
+	RemoveGuildVoiceActivityDenyRole(ctx context.Context, arg RemoveGuildVoiceActivityDenyRoleParams) ([]string, error)
 	ResetMemberProfile(ctx context.Context, arg ResetMemberProfileParams) error
+	// The channel IDs should be ordered by priority, for example: the channel, its parent channel and then its category.
+	ResolveChatActivityChannelMultiplier(ctx context.Context, arg ResolveChatActivityChannelMultiplierParams) (float32, error)
+	// The last grant is left alone, so adjustments don't affect the member's grant cooldown.
+	SetMemberActivityPoints(ctx context.Context, arg SetMemberActivityPointsParams) error
+	StartActivityBoosts(ctx context.Context) ([]GuildActivityBoost, error)
+	UpdateAPIKey(ctx context.Context, arg UpdateAPIKeyParams) (ApiKey, error)
+	UpdateActivityBoost(ctx context.Context, arg UpdateActivityBoostParams) (GuildActivityBoost, error)
+	UpdateActivityRole(ctx context.Context, arg UpdateActivityRoleParams) (UpdateActivityRoleRow, error)
+	// Also renews the job's lock, so it isn't claimed by another run while this one is still processing it.
+	// Nothing is updated when the cursor has moved since the batch was read, which means another run has taken over the job.
+	UpdateActivityRoleResyncJobProgress(ctx context.Context, arg UpdateActivityRoleResyncJobProgressParams) (int64, error)
+	UpdateGuildAuditLogChannel(ctx context.Context, arg UpdateGuildAuditLogChannelParams) (string, error)
 	UpdateGuildChatActivitySettings(ctx context.Context, arg UpdateGuildChatActivitySettingsParams) error
 	UpdateGuildMessageEmbedSettings(ctx context.Context, arg UpdateGuildMessageEmbedSettingsParams) error
 	UpdateGuildVoiceActivitySettings(ctx context.Context, arg UpdateGuildVoiceActivitySettingsParams) error
 	UpdateVoiceRoom(ctx context.Context, arg UpdateVoiceRoomParams) (GuildActiveVoiceRoom, error)
 	UpdateVoiceRoomLobby(ctx context.Context, arg UpdateVoiceRoomLobbyParams) (GuildVoiceRoomsSetting, error)
+	// The time since the last update is only accrued if the member was eligible during it.
+	UpdateVoiceSession(ctx context.Context, arg UpdateVoiceSessionParams) (GuildVoiceSession, error)
+	UpsertChatActivityChannelMultiplier(ctx context.Context, arg UpsertChatActivityChannelMultiplierParams) (UpsertChatActivityChannelMultiplierRow, error)
 }
 
 var _ Querier = (*Queries)(nil)
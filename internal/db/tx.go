@@ -0,0 +1,17 @@
+package db
+
+import "database/sql"
+
+// TxQuerier is a Querier that can also run its queries inside of a transaction.
+// This lets the queries be wrapped (i.e. for tracing) without losing access to transactions.
+type TxQuerier interface {
+	Querier
+	InTx(tx *sql.Tx) TxQuerier
+}
+
+var _ TxQuerier = (*Queries)(nil)
+
+// InTx is the same as WithTx, but returns the TxQuerier interface.
+func (q *Queries) InTx(tx *sql.Tx) TxQuerier {
+	return q.WithTx(tx)
+}
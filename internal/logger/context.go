@@ -0,0 +1,42 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+type contextKey string
+
+const requestIdContextKey contextKey = "request_id"
+
+// WithRequestID attaches the request ID to the context.
+// Anything logged with logrus.WithContext will include it.
+func WithRequestID(ctx context.Context, requestId string) context.Context {
+	return context.WithValue(ctx, requestIdContextKey, requestId)
+}
+
+// RequestID gets the request ID attached to the context, this is empty when there isn't one.
+func RequestID(ctx context.Context) string {
+	requestId, _ := ctx.Value(requestIdContextKey).(string)
+	return requestId
+}
+
+// requestIdHook adds the request ID from the entry's context to its fields.
+type requestIdHook struct{}
+
+func (h *requestIdHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *requestIdHook) Fire(entry *logrus.Entry) error {
+	if entry.Context == nil {
+		return nil
+	}
+
+	if requestId := RequestID(entry.Context); requestId != "" {
+		entry.Data["request_id"] = requestId
+	}
+
+	return nil
+}
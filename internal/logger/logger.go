@@ -9,6 +9,10 @@ import (
 	"github.com/typical-developers/discord-bot-backend/pkg/bufferpool"
 )
 
+// The timestamp format used by both log formats.
+const timestampFormat = "2006-01-02T15:04:05.000Z07:00"
+
+// Formatter is the human-readable log format, which is meant for reading logs locally.
 type Formatter struct{}
 
 func (f *Formatter) Format(entry *logrus.Entry) ([]byte, error) {
@@ -17,7 +21,7 @@ func (f *Formatter) Format(entry *logrus.Entry) ([]byte, error) {
 
 	fmt.Fprintf(
 		buff, "[%s] %s: %s\n",
-		entry.Time.Format("2006-01-02T15:04:05.000Z"),
+		entry.Time.Format(timestampFormat),
 		strings.ToUpper(entry.Level.String()), entry.Message,
 	)
 
@@ -33,6 +37,18 @@ func (f *Formatter) Format(entry *logrus.Entry) ([]byte, error) {
 	return buff.Bytes(), nil
 }
 
+// SetFormat sets how logs are written, either "json" for one JSON object per line or "text" for the human-readable format.
+// Anything other than "text" uses JSON, so log collectors can parse every entry's fields.
+func SetFormat(format string) {
+	if format == "text" {
+		logrus.SetFormatter(&Formatter{})
+		return
+	}
+
+	logrus.SetFormatter(&logrus.JSONFormatter{TimestampFormat: timestampFormat})
+}
+
 func init() {
-	logrus.SetFormatter(&Formatter{})
+	SetFormat("json")
+	logrus.AddHook(&requestIdHook{})
 }
@@ -0,0 +1,88 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	HTTPRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "The amount of HTTP requests handled, by route pattern, method and status.",
+	}, []string{"route", "method", "status"})
+
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "How long HTTP requests took to handle, by route pattern, method and status.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"route", "method", "status"})
+
+	CardRenderDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "card_render_duration_seconds",
+		Help:    "How long cards took to render, by card.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"card"})
+
+	CronJobRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cron_job_runs_total",
+		Help: "The amount of times each cron job has run.",
+	}, []string{"job"})
+
+	CronJobFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cron_job_failures_total",
+		Help: "The amount of times each cron job has failed.",
+	}, []string{"job"})
+
+	CronJobDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "cron_job_duration_seconds",
+		Help:    "How long each cron job took to run.",
+		Buckets: []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120, 300},
+	}, []string{"job"})
+)
+
+// Register adds additional collectors (i.e. database pool stats) to the default registry.
+func Register(collectors ...prometheus.Collector) {
+	for _, collector := range collectors {
+		if err := prometheus.Register(collector); err != nil {
+			log.WithError(err).Warn("Failed to register metrics collector.")
+		}
+	}
+}
+
+// ObserveRender records how long a card took to render since start.
+func ObserveRender(card string, start time.Time) {
+	CardRenderDuration.WithLabelValues(card).Observe(time.Since(start).Seconds())
+}
+
+// Serve exposes the metrics on their own port until the context is done.
+// They're kept off the API's port so they don't need an API key and aren't publicly reachable.
+func Serve(ctx context.Context, port int) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", port),
+		Handler: mux,
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.WithError(err).Error("Failed to serve metrics.")
+	}
+}
@@ -34,3 +34,18 @@ func ChatBubbleIcon(props IconProps) Node {
 		),
 	)
 }
+
+func MicrophoneIcon(props IconProps) Node {
+	return Icon(
+		IconProps{
+			Width:  props.Width,
+			Height: props.Height,
+		},
+		Raw(
+			`<svg width="100%" height="100%" viewBox="0 0 100 100" fill="none" xmlns="http://www.w3.org/2000/svg">
+				<path d="M50 64.5833C60.3542 64.5833 68.75 56.1875 68.75 45.8333V25C68.75 14.6458 60.3542 6.25 50 6.25C39.6458 6.25 31.25 14.6458 31.25 25V45.8333C31.25 56.1875 39.6458 64.5833 50 64.5833Z" fill="currentColor"/>
+				<path d="M81.125 37.0833C79.5 37.0833 78.2083 38.375 78.2083 40V46.5833C78.2083 62.1667 65.5417 74.8333 49.9583 74.8333C34.375 74.8333 21.7083 62.1667 21.7083 46.5833V39.9583C21.7083 38.3333 20.4167 37.0417 18.7917 37.0417C17.1667 37.0417 15.875 38.3333 15.875 39.9583V46.5417C15.875 63.5 28.9167 77.4583 45.5417 78.9583V87.8333C45.5417 90.2917 47.5 92.25 49.9583 92.25C52.4167 92.25 54.375 90.2917 54.375 87.8333V78.9583C71 77.5 84.0417 63.5 84.0417 46.5417V39.9583C84.0417 38.375 82.75 37.0833 81.125 37.0833Z" fill="currentColor"/>
+			</svg>`,
+		),
+	)
+}
@@ -48,7 +48,8 @@ type UserInfoProps struct {
 	DisplayName string
 	Username    string
 
-	TopChatActivityRole *ActivityRole
+	TopChatActivityRole  *ActivityRole
+	TopVoiceActivityRole *ActivityRole
 }
 
 func UserInfo(props UserInfoProps) Node {
@@ -61,6 +62,15 @@ func UserInfo(props UserInfoProps) Node {
 		})
 	}
 
+	var topVoiceActivityRole Node
+	if props.TopVoiceActivityRole != nil && props.TopVoiceActivityRole.Text != "" && props.TopVoiceActivityRole.Accent != "" {
+		topVoiceActivityRole = Tag(TagProps{
+			Accent: props.TopVoiceActivityRole.Accent,
+			Icon:   MicrophoneIcon(IconProps{Width: "18px", Height: "18px"}),
+			Text:   props.TopVoiceActivityRole.Text,
+		})
+	}
+
 	return Div(
 		Class("user-info"),
 		Div(
@@ -78,6 +88,7 @@ func UserInfo(props UserInfoProps) Node {
 			Class("tags"),
 
 			If(props.TopChatActivityRole != nil, topActivityRole),
+			If(props.TopVoiceActivityRole != nil, topVoiceActivityRole),
 		))
 }
 
@@ -122,11 +133,33 @@ type RankingInfo struct {
 	Monthly int
 }
 
+// ActivityStreakProps is the member's weekly activity streak, along with how their last week compares to the one before it.
+type ActivityStreakProps struct {
+	Weeks int
+	// Either "up", "down" or "flat".
+	Trend string
+}
+
+func ActivityStreak(props ActivityStreakProps) Node {
+	return Div(
+		Class("streak"),
+		If(props.Weeks > 0, Typography(TypographyProps{
+			Size:   FontSizeSmall,
+			Weight: FontWeightBold,
+		}, Text(pages.Format.Sprintf("%d Week Streak", props.Weeks)))),
+		If(props.Trend == "up" || props.Trend == "down", Div(Classes{
+			"trend":     true,
+			props.Trend: true,
+		})),
+	)
+}
+
 type ProgressGroupHeaderProps struct {
 	ActivityType string
 	Icon         Node
 	Ranking      RankingInfo
 	TotalPoints  int
+	Streak       ActivityStreakProps
 }
 
 func ProgressGroupHeader(props ProgressGroupHeaderProps) Node {
@@ -139,10 +172,14 @@ func ProgressGroupHeader(props ProgressGroupHeaderProps) Node {
 				Size:   FontSizeNormal,
 				Weight: FontWeightBlack,
 			}, Text(pages.Format.Sprintf("%s Activity", props.ActivityType))),
-			Typography(TypographyProps{
-				Size:   FontSizeNormal,
-				Weight: FontWeightRegular,
-			}, Text(pages.Format.Sprintf("%d Points", props.TotalPoints))),
+			Div(
+				Class("points"),
+				Typography(TypographyProps{
+					Size:   FontSizeNormal,
+					Weight: FontWeightRegular,
+				}, Text(pages.Format.Sprintf("%d Points", props.TotalPoints))),
+				If(props.Streak.Weeks > 0 || props.Streak.Trend == "up" || props.Streak.Trend == "down", ActivityStreak(props.Streak)),
+			),
 		),
 		Div(
 			Class("details ranking"),
@@ -177,6 +214,7 @@ type ProgressGroupProps struct {
 	Icon           Node
 	Ranking        RankingInfo
 	TotalPoints    int
+	Streak         ActivityStreakProps
 	CurrentPoints  int
 	RequiredPoints int
 }
@@ -188,6 +226,7 @@ func ProgressGroup(props ProgressGroupProps) Node {
 			Icon:         props.Icon,
 			Ranking:      props.Ranking,
 			TotalPoints:  props.TotalPoints,
+			Streak:       props.Streak,
 			ActivityType: props.ActivityType,
 		}),
 		ProgressBar(ProgressBarProps{
@@ -203,6 +242,7 @@ type ActivityInfo struct {
 	RoleCurrentPoints  int
 	RoleRequiredPoints int
 	CurrentTitleInfo   *ActivityRole
+	Streak             ActivityStreakProps
 }
 
 type CardStyling struct {
@@ -220,9 +260,27 @@ type ProfileCardProps struct {
 	Username     string
 	AvatarURL    string
 	ChatActivity ActivityInfo
+
+	// The voice activity progress is only shown when this is set.
+	VoiceActivity *ActivityInfo
 }
 
 func ProfileCard(props ProfileCardProps) Node {
+	var topVoiceActivityRole *ActivityRole
+	var voiceProgressGroup Node
+	if props.VoiceActivity != nil {
+		topVoiceActivityRole = props.VoiceActivity.CurrentTitleInfo
+		voiceProgressGroup = ProgressGroup(ProgressGroupProps{
+			ActivityType:   "Voice",
+			Icon:           MicrophoneIcon(IconProps{Width: "26px", Height: "26px"}),
+			Ranking:        props.VoiceActivity.Ranking,
+			TotalPoints:    props.VoiceActivity.TotalPoints,
+			Streak:         props.VoiceActivity.Streak,
+			CurrentPoints:  props.VoiceActivity.RoleCurrentPoints,
+			RequiredPoints: props.VoiceActivity.RoleRequiredPoints,
+		})
+	}
+
 	cardStyling := &CardStyling{
 		Gradient1HSL:       "21, 97%, 69%",
 		Gradient2HSL:       "270, 94%, 64%",
@@ -274,9 +332,10 @@ func ProfileCard(props ProfileCardProps) Node {
 							URL: props.AvatarURL,
 						}),
 						UserInfo(UserInfoProps{
-							DisplayName:         props.DisplayName,
-							Username:            props.Username,
-							TopChatActivityRole: props.ChatActivity.CurrentTitleInfo,
+							DisplayName:          props.DisplayName,
+							Username:             props.Username,
+							TopChatActivityRole:  props.ChatActivity.CurrentTitleInfo,
+							TopVoiceActivityRole: topVoiceActivityRole,
 						}),
 					),
 					Div(
@@ -291,9 +350,11 @@ func ProfileCard(props ProfileCardProps) Node {
 							Icon:           ChatBubbleIcon(IconProps{Width: "26px", Height: "26px"}),
 							Ranking:        props.ChatActivity.Ranking,
 							TotalPoints:    props.ChatActivity.TotalPoints,
+							Streak:         props.ChatActivity.Streak,
 							CurrentPoints:  props.ChatActivity.RoleCurrentPoints,
 							RequiredPoints: props.ChatActivity.RoleRequiredPoints,
 						}),
+						If(props.VoiceActivity != nil, voiceProgressGroup),
 					),
 				),
 				Div(
@@ -0,0 +1,368 @@
+// Generates the tracing wrappers for the database querier and the usecases, so every method gets a span without writing it by hand.
+// This is run by go generate from the tracing package, use `task tracing:generate` after changing the queries or the usecase interfaces.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const header = "// Code generated by internal/tracing/gen. DO NOT EDIT.\n\n"
+
+// wrappedInterface is an interface that a tracing wrapper is generated for.
+type wrappedInterface struct {
+	// The file the interface is declared in, relative to the tracing package.
+	File string
+	Name string
+
+	// The receiver and the type of the generated wrapper.
+	Receiver string
+	Type     string
+	// The field of the wrapper holding the wrapped value.
+	Field string
+
+	// The span name is the prefix followed by the method's name.
+	SpanPrefix string
+	// Extra attributes set on every span, as Go source.
+	Attributes func(method string) string
+}
+
+type method struct {
+	Name    string
+	Params  []string
+	Args    []string
+	Results []string
+}
+
+// packageTypes gets every type declared in the package, so they can be qualified when they're used outside of it.
+func packageTypes(fset *token.FileSet, dir string) (map[string]bool, error) {
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	types := make(map[string]bool)
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+
+				for _, spec := range gen.Specs {
+					types[spec.(*ast.TypeSpec).Name.Name] = true
+				}
+			}
+		}
+	}
+
+	return types, nil
+}
+
+// qualify prefixes the package's types with its import name, since the wrappers are declared in another package.
+func qualify(expr ast.Expr, types map[string]bool, pkgName string) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if types[e.Name] {
+			return &ast.SelectorExpr{X: ast.NewIdent(pkgName), Sel: ast.NewIdent(e.Name)}
+		}
+	case *ast.StarExpr:
+		e.X = qualify(e.X, types, pkgName)
+	case *ast.ArrayType:
+		e.Elt = qualify(e.Elt, types, pkgName)
+	case *ast.MapType:
+		e.Key = qualify(e.Key, types, pkgName)
+		e.Value = qualify(e.Value, types, pkgName)
+	case *ast.Ellipsis:
+		e.Elt = qualify(e.Elt, types, pkgName)
+	}
+
+	return expr
+}
+
+// imports gets the packages that the types refer to, along with the import paths they have in the interface's file.
+func imports(expr ast.Expr, file *ast.File, found map[string]string) {
+	ast.Inspect(expr, func(node ast.Node) bool {
+		selector, ok := node.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		ident, ok := selector.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		for _, spec := range file.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+
+			name := filepath.Base(path)
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+
+			if name == ident.Name {
+				found[path] = name
+			}
+		}
+
+		return false
+	})
+}
+
+func exprString(fset *token.FileSet, expr ast.Expr) string {
+	var buf bytes.Buffer
+	_ = printer.Fprint(&buf, fset, expr)
+	return buf.String()
+}
+
+// interfaceMethods gets the methods of the interface, with the package's types qualified by its import name.
+func interfaceMethods(fset *token.FileSet, iface wrappedInterface, pkgName string, found map[string]string) ([]method, error) {
+	types, err := packageTypes(fset, filepath.Dir(iface.File))
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := parser.ParseFile(fset, iface.File, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec *ast.InterfaceType
+	ast.Inspect(file, func(node ast.Node) bool {
+		typeSpec, ok := node.(*ast.TypeSpec)
+		if ok && typeSpec.Name.Name == iface.Name {
+			spec, _ = typeSpec.Type.(*ast.InterfaceType)
+			return false
+		}
+
+		return spec == nil
+	})
+	if spec == nil {
+		return nil, fmt.Errorf("%s: interface %s not found", iface.File, iface.Name)
+	}
+
+	methods := make([]method, 0, len(spec.Methods.List))
+	for _, field := range spec.Methods.List {
+		fn, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) == 0 {
+			return nil, fmt.Errorf("%s: %s can only have methods", iface.File, iface.Name)
+		}
+
+		m := method{Name: field.Names[0].Name}
+		for index, param := range fn.Params.List {
+			typ := qualify(param.Type, types, pkgName)
+			imports(typ, file, found)
+
+			names := make([]string, 0, len(param.Names))
+			for _, name := range param.Names {
+				names = append(names, name.Name)
+			}
+			if len(names) == 0 {
+				names = append(names, fmt.Sprintf("arg%d", index))
+			}
+
+			m.Params = append(m.Params, fmt.Sprintf("%s %s", strings.Join(names, ", "), exprString(fset, typ)))
+			m.Args = append(m.Args, names...)
+		}
+
+		if fn.Results != nil {
+			for _, result := range fn.Results.List {
+				typ := qualify(result.Type, types, pkgName)
+				imports(typ, file, found)
+
+				m.Results = append(m.Results, exprString(fset, typ))
+			}
+		}
+
+		if len(m.Results) == 0 || len(m.Results) > 2 || m.Results[len(m.Results)-1] != "error" {
+			return nil, fmt.Errorf("%s: %s.%s has to return an error, optionally with a single result", iface.File, iface.Name, m.Name)
+		}
+
+		methods = append(methods, m)
+	}
+
+	return methods, nil
+}
+
+func writeMethods(buf *bytes.Buffer, iface wrappedInterface, methods []method) {
+	for _, m := range methods {
+		attrs := ""
+		if iface.Attributes != nil {
+			attrs = ", " + iface.Attributes(m.Name)
+		}
+
+		fmt.Fprintf(buf, "\nfunc (%s *%s) %s(%s) ", iface.Receiver, iface.Type, m.Name, strings.Join(m.Params, ", "))
+		if len(m.Results) == 1 {
+			buf.WriteString("error {\n")
+		} else {
+			fmt.Fprintf(buf, "(%s) {\n", strings.Join(m.Results, ", "))
+		}
+
+		call := fmt.Sprintf("%s.%s.%s(%s)", iface.Receiver, iface.Field, m.Name, strings.Join(m.Args, ", "))
+		fmt.Fprintf(buf, "\tctx, span := Start(ctx, %q%s)\n", iface.SpanPrefix+m.Name, attrs)
+		if len(m.Results) == 1 {
+			fmt.Fprintf(buf, "\terr := %s\n\tEnd(span, err)\n\n\treturn err\n}\n", call)
+		} else {
+			fmt.Fprintf(buf, "\tresult, err := %s\n\tEnd(span, err)\n\n\treturn result, err\n}\n", call)
+		}
+	}
+}
+
+// writeImports writes the imports with the standard library grouped before everything else, the same as goimports.
+func writeImports(buf *bytes.Buffer, found map[string]string) {
+	var std, others []string
+	for path := range found {
+		if first, _, _ := strings.Cut(path, "/"); strings.Contains(first, ".") {
+			others = append(others, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(others)
+
+	buf.WriteString("import (\n")
+	for index, group := range [][]string{std, others} {
+		if index > 0 && len(std) > 0 && len(group) > 0 {
+			buf.WriteString("\n")
+		}
+
+		for _, path := range group {
+			if name := found[path]; name != filepath.Base(path) {
+				fmt.Fprintf(buf, "\t%s %q\n", name, path)
+			} else {
+				fmt.Fprintf(buf, "\t%q\n", path)
+			}
+		}
+	}
+	buf.WriteString(")\n")
+}
+
+func writeFile(path string, buf *bytes.Buffer) error {
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return os.WriteFile(path, source, 0o644)
+}
+
+func generateQuerier(fset *token.FileSet) error {
+	iface := wrappedInterface{
+		File:       "../db/querier.go",
+		Name:       "Querier",
+		Receiver:   "q",
+		Type:       "Querier",
+		Field:      "q",
+		SpanPrefix: "db.",
+		Attributes: func(method string) string {
+			return fmt.Sprintf("dbSystem, attribute.String(\"db.operation.name\", %q)", method)
+		},
+	}
+
+	found := map[string]string{
+		"database/sql": "sql",
+		"github.com/typical-developers/discord-bot-backend/internal/db": "db",
+		"go.opentelemetry.io/otel/attribute":                            "attribute",
+	}
+	methods, err := interfaceMethods(fset, iface, "db", found)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(header + "package tracing\n\n")
+	writeImports(&buf, found)
+	buf.WriteString(`
+var dbSystem = attribute.String("db.system", "postgresql")
+
+// Querier wraps every query in a span named after it.
+type Querier struct {
+	q db.TxQuerier
+}
+
+var _ db.TxQuerier = (*Querier)(nil)
+
+func NewQuerier(q db.TxQuerier) *Querier {
+	return &Querier{q: q}
+}
+
+func (q *Querier) InTx(tx *sql.Tx) db.TxQuerier {
+	return &Querier{q: q.q.InTx(tx)}
+}
+`)
+	writeMethods(&buf, iface, methods)
+
+	return writeFile("querier.go", &buf)
+}
+
+func generateUsecases(fset *token.FileSet) error {
+	ifaces := []wrappedInterface{
+		{File: "../usecase/guilds.go", Name: "GuildsUsecase", Type: "guildsUsecase"},
+		{File: "../usecase/members.go", Name: "MemberUsecase", Type: "memberUsecase"},
+		{File: "../usecase/auth.go", Name: "AuthUsecase", Type: "authUsecase"},
+	}
+
+	found := map[string]string{
+		"github.com/typical-developers/discord-bot-backend/internal/usecase": "u",
+	}
+
+	var body bytes.Buffer
+	for _, iface := range ifaces {
+		iface.Receiver = "uc"
+		iface.Field = "uc"
+		iface.SpanPrefix = iface.Name + "."
+
+		methods, err := interfaceMethods(fset, iface, "u", found)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(&body, `
+type %[1]s struct {
+	uc u.%[2]s
+}
+
+// New%[2]s wraps every usecase method in a span named after it.
+func New%[2]s(uc u.%[2]s) u.%[2]s {
+	return &%[1]s{uc: uc}
+}
+`, iface.Type, iface.Name)
+		writeMethods(&body, iface, methods)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(header + "package tracing\n\n")
+	writeImports(&buf, found)
+	buf.Write(body.Bytes())
+
+	return writeFile("usecases.go", &buf)
+}
+
+func main() {
+	fset := token.NewFileSet()
+
+	if err := generateQuerier(fset); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if err := generateUsecases(fset); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
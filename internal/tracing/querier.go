@@ -0,0 +1,900 @@
+// Code generated by internal/tracing/gen. DO NOT EDIT.
+
+package tracing
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typical-developers/discord-bot-backend/internal/db"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var dbSystem = attribute.String("db.system", "postgresql")
+
+// Querier wraps every query in a span named after it.
+type Querier struct {
+	q db.TxQuerier
+}
+
+var _ db.TxQuerier = (*Querier)(nil)
+
+func NewQuerier(q db.TxQuerier) *Querier {
+	return &Querier{q: q}
+}
+
+func (q *Querier) InTx(tx *sql.Tx) db.TxQuerier {
+	return &Querier{q: q.q.InTx(tx)}
+}
+
+func (q *Querier) ActivityRoleThresholdExists(ctx context.Context, arg db.ActivityRoleThresholdExistsParams) (bool, error) {
+	ctx, span := Start(ctx, "db.ActivityRoleThresholdExists", dbSystem, attribute.String("db.operation.name", "ActivityRoleThresholdExists"))
+	result, err := q.q.ActivityRoleThresholdExists(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) AppendGuildChatActivityDenyRole(ctx context.Context, arg db.AppendGuildChatActivityDenyRoleParams) ([]string, error) {
+	ctx, span := Start(ctx, "db.AppendGuildChatActivityDenyRole", dbSystem, attribute.String("db.operation.name", "AppendGuildChatActivityDenyRole"))
+	result, err := q.q.AppendGuildChatActivityDenyRole(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) AppendGuildMessageEmbedSettingsArrays(ctx context.Context, arg db.AppendGuildMessageEmbedSettingsArraysParams) error {
+	ctx, span := Start(ctx, "db.AppendGuildMessageEmbedSettingsArrays", dbSystem, attribute.String("db.operation.name", "AppendGuildMessageEmbedSettingsArrays"))
+	err := q.q.AppendGuildMessageEmbedSettingsArrays(ctx, arg)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) AppendGuildVoiceActivityDenyRole(ctx context.Context, arg db.AppendGuildVoiceActivityDenyRoleParams) ([]string, error) {
+	ctx, span := Start(ctx, "db.AppendGuildVoiceActivityDenyRole", dbSystem, attribute.String("db.operation.name", "AppendGuildVoiceActivityDenyRole"))
+	result, err := q.q.AppendGuildVoiceActivityDenyRole(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) ArchiveMonthlyActivityLeaderboard(ctx context.Context) error {
+	ctx, span := Start(ctx, "db.ArchiveMonthlyActivityLeaderboard", dbSystem, attribute.String("db.operation.name", "ArchiveMonthlyActivityLeaderboard"))
+	err := q.q.ArchiveMonthlyActivityLeaderboard(ctx)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) ArchiveWeeklyActivityLeaderboard(ctx context.Context) error {
+	ctx, span := Start(ctx, "db.ArchiveWeeklyActivityLeaderboard", dbSystem, attribute.String("db.operation.name", "ArchiveWeeklyActivityLeaderboard"))
+	err := q.q.ArchiveWeeklyActivityLeaderboard(ctx)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) BulkIncrementMemberChatActivityPoints(ctx context.Context, arg db.BulkIncrementMemberChatActivityPointsParams) error {
+	ctx, span := Start(ctx, "db.BulkIncrementMemberChatActivityPoints", dbSystem, attribute.String("db.operation.name", "BulkIncrementMemberChatActivityPoints"))
+	err := q.q.BulkIncrementMemberChatActivityPoints(ctx, arg)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) BulkIncrementMonthlyActivityLeaderboard(ctx context.Context, arg db.BulkIncrementMonthlyActivityLeaderboardParams) error {
+	ctx, span := Start(ctx, "db.BulkIncrementMonthlyActivityLeaderboard", dbSystem, attribute.String("db.operation.name", "BulkIncrementMonthlyActivityLeaderboard"))
+	err := q.q.BulkIncrementMonthlyActivityLeaderboard(ctx, arg)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) BulkIncrementWeeklyActivityLeaderboard(ctx context.Context, arg db.BulkIncrementWeeklyActivityLeaderboardParams) error {
+	ctx, span := Start(ctx, "db.BulkIncrementWeeklyActivityLeaderboard", dbSystem, attribute.String("db.operation.name", "BulkIncrementWeeklyActivityLeaderboard"))
+	err := q.q.BulkIncrementWeeklyActivityLeaderboard(ctx, arg)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) BulkInsertChatActivityLedgerEntries(ctx context.Context, arg db.BulkInsertChatActivityLedgerEntriesParams) error {
+	ctx, span := Start(ctx, "db.BulkInsertChatActivityLedgerEntries", dbSystem, attribute.String("db.operation.name", "BulkInsertChatActivityLedgerEntries"))
+	err := q.q.BulkInsertChatActivityLedgerEntries(ctx, arg)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) ClaimActivityRoleResyncJob(ctx context.Context, lockedUntil int32) (db.GuildActivityRoleResyncJob, error) {
+	ctx, span := Start(ctx, "db.ClaimActivityRoleResyncJob", dbSystem, attribute.String("db.operation.name", "ClaimActivityRoleResyncJob"))
+	result, err := q.q.ClaimActivityRoleResyncJob(ctx, lockedUntil)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) CloseVoiceSession(ctx context.Context, arg db.CloseVoiceSessionParams) (db.CloseVoiceSessionRow, error) {
+	ctx, span := Start(ctx, "db.CloseVoiceSession", dbSystem, attribute.String("db.operation.name", "CloseVoiceSession"))
+	result, err := q.q.CloseVoiceSession(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) CompactActivityLedger(ctx context.Context, arg db.CompactActivityLedgerParams) (int64, error) {
+	ctx, span := Start(ctx, "db.CompactActivityLedger", dbSystem, attribute.String("db.operation.name", "CompactActivityLedger"))
+	result, err := q.q.CompactActivityLedger(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) CountMemberActivityLedger(ctx context.Context, arg db.CountMemberActivityLedgerParams) (int32, error) {
+	ctx, span := Start(ctx, "db.CountMemberActivityLedger", dbSystem, attribute.String("db.operation.name", "CountMemberActivityLedger"))
+	result, err := q.q.CountMemberActivityLedger(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) CountSettingsAuditLog(ctx context.Context, guildID string) (int32, error) {
+	ctx, span := Start(ctx, "db.CountSettingsAuditLog", dbSystem, attribute.String("db.operation.name", "CountSettingsAuditLog"))
+	result, err := q.q.CountSettingsAuditLog(ctx, guildID)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) CreateAPIKey(ctx context.Context, arg db.CreateAPIKeyParams) (db.ApiKey, error) {
+	ctx, span := Start(ctx, "db.CreateAPIKey", dbSystem, attribute.String("db.operation.name", "CreateAPIKey"))
+	result, err := q.q.CreateAPIKey(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) CreateActivityBoost(ctx context.Context, arg db.CreateActivityBoostParams) (db.GuildActivityBoost, error) {
+	ctx, span := Start(ctx, "db.CreateActivityBoost", dbSystem, attribute.String("db.operation.name", "CreateActivityBoost"))
+	result, err := q.q.CreateActivityBoost(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) CreateActivityRoleResyncJob(ctx context.Context, guildID string) (db.GuildActivityRoleResyncJob, error) {
+	ctx, span := Start(ctx, "db.CreateActivityRoleResyncJob", dbSystem, attribute.String("db.operation.name", "CreateActivityRoleResyncJob"))
+	result, err := q.q.CreateActivityRoleResyncJob(ctx, guildID)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) CreateMemberProfile(ctx context.Context, arg db.CreateMemberProfileParams) (db.GuildProfile, error) {
+	ctx, span := Start(ctx, "db.CreateMemberProfile", dbSystem, attribute.String("db.operation.name", "CreateMemberProfile"))
+	result, err := q.q.CreateMemberProfile(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) CreateVoiceRoomLobby(ctx context.Context, arg db.CreateVoiceRoomLobbyParams) (db.GuildVoiceRoomsSetting, error) {
+	ctx, span := Start(ctx, "db.CreateVoiceRoomLobby", dbSystem, attribute.String("db.operation.name", "CreateVoiceRoomLobby"))
+	result, err := q.q.CreateVoiceRoomLobby(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) DeductMonthlyActivityLeaderboard(ctx context.Context, arg db.DeductMonthlyActivityLeaderboardParams) error {
+	ctx, span := Start(ctx, "db.DeductMonthlyActivityLeaderboard", dbSystem, attribute.String("db.operation.name", "DeductMonthlyActivityLeaderboard"))
+	err := q.q.DeductMonthlyActivityLeaderboard(ctx, arg)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) DeductWeeklyActivityLeaderboard(ctx context.Context, arg db.DeductWeeklyActivityLeaderboardParams) error {
+	ctx, span := Start(ctx, "db.DeductWeeklyActivityLeaderboard", dbSystem, attribute.String("db.operation.name", "DeductWeeklyActivityLeaderboard"))
+	err := q.q.DeductWeeklyActivityLeaderboard(ctx, arg)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) DeleteAPIKey(ctx context.Context, keyID string) (string, error) {
+	ctx, span := Start(ctx, "db.DeleteAPIKey", dbSystem, attribute.String("db.operation.name", "DeleteAPIKey"))
+	result, err := q.q.DeleteAPIKey(ctx, keyID)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) DeleteActivityBoost(ctx context.Context, arg db.DeleteActivityBoostParams) (int64, error) {
+	ctx, span := Start(ctx, "db.DeleteActivityBoost", dbSystem, attribute.String("db.operation.name", "DeleteActivityBoost"))
+	result, err := q.q.DeleteActivityBoost(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) DeleteActivityRole(ctx context.Context, arg db.DeleteActivityRoleParams) (int64, error) {
+	ctx, span := Start(ctx, "db.DeleteActivityRole", dbSystem, attribute.String("db.operation.name", "DeleteActivityRole"))
+	result, err := q.q.DeleteActivityRole(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) DeleteChatActivityChannelMultiplier(ctx context.Context, arg db.DeleteChatActivityChannelMultiplierParams) (int64, error) {
+	ctx, span := Start(ctx, "db.DeleteChatActivityChannelMultiplier", dbSystem, attribute.String("db.operation.name", "DeleteChatActivityChannelMultiplier"))
+	result, err := q.q.DeleteChatActivityChannelMultiplier(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) DeleteExpiredActivityBoosts(ctx context.Context) ([]db.GuildActivityBoost, error) {
+	ctx, span := Start(ctx, "db.DeleteExpiredActivityBoosts", dbSystem, attribute.String("db.operation.name", "DeleteExpiredActivityBoosts"))
+	result, err := q.q.DeleteExpiredActivityBoosts(ctx)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) DeleteVoiceRoom(ctx context.Context, arg db.DeleteVoiceRoomParams) error {
+	ctx, span := Start(ctx, "db.DeleteVoiceRoom", dbSystem, attribute.String("db.operation.name", "DeleteVoiceRoom"))
+	err := q.q.DeleteVoiceRoom(ctx, arg)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) DeleteVoiceRoomLobby(ctx context.Context, arg db.DeleteVoiceRoomLobbyParams) error {
+	ctx, span := Start(ctx, "db.DeleteVoiceRoomLobby", dbSystem, attribute.String("db.operation.name", "DeleteVoiceRoomLobby"))
+	err := q.q.DeleteVoiceRoomLobby(ctx, arg)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) EnsureMemberProfile(ctx context.Context, arg db.EnsureMemberProfileParams) (int64, error) {
+	ctx, span := Start(ctx, "db.EnsureMemberProfile", dbSystem, attribute.String("db.operation.name", "EnsureMemberProfile"))
+	result, err := q.q.EnsureMemberProfile(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) FinishActivityRoleResyncJob(ctx context.Context, arg db.FinishActivityRoleResyncJobParams) error {
+	ctx, span := Start(ctx, "db.FinishActivityRoleResyncJob", dbSystem, attribute.String("db.operation.name", "FinishActivityRoleResyncJob"))
+	err := q.q.FinishActivityRoleResyncJob(ctx, arg)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) FlushOudatedMonthlyActivityLeaderboard(ctx context.Context) error {
+	ctx, span := Start(ctx, "db.FlushOudatedMonthlyActivityLeaderboard", dbSystem, attribute.String("db.operation.name", "FlushOudatedMonthlyActivityLeaderboard"))
+	err := q.q.FlushOudatedMonthlyActivityLeaderboard(ctx)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) FlushOudatedWeeklyActivityLeaderboard(ctx context.Context) error {
+	ctx, span := Start(ctx, "db.FlushOudatedWeeklyActivityLeaderboard", dbSystem, attribute.String("db.operation.name", "FlushOudatedWeeklyActivityLeaderboard"))
+	err := q.q.FlushOudatedWeeklyActivityLeaderboard(ctx)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) GetAPIKeyByHash(ctx context.Context, keyHash string) (db.ApiKey, error) {
+	ctx, span := Start(ctx, "db.GetAPIKeyByHash", dbSystem, attribute.String("db.operation.name", "GetAPIKeyByHash"))
+	result, err := q.q.GetAPIKeyByHash(ctx, keyHash)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetAPIKeys(ctx context.Context) ([]db.ApiKey, error) {
+	ctx, span := Start(ctx, "db.GetAPIKeys", dbSystem, attribute.String("db.operation.name", "GetAPIKeys"))
+	result, err := q.q.GetAPIKeys(ctx)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetActiveActivityBoosts(ctx context.Context, arg db.GetActiveActivityBoostsParams) ([]db.GuildActivityBoost, error) {
+	ctx, span := Start(ctx, "db.GetActiveActivityBoosts", dbSystem, attribute.String("db.operation.name", "GetActiveActivityBoosts"))
+	result, err := q.q.GetActiveActivityBoosts(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetActivityBoost(ctx context.Context, arg db.GetActivityBoostParams) (db.GuildActivityBoost, error) {
+	ctx, span := Start(ctx, "db.GetActivityBoost", dbSystem, attribute.String("db.operation.name", "GetActivityBoost"))
+	result, err := q.q.GetActivityBoost(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetActivityBoosts(ctx context.Context, guildID string) ([]db.GuildActivityBoost, error) {
+	ctx, span := Start(ctx, "db.GetActivityBoosts", dbSystem, attribute.String("db.operation.name", "GetActivityBoosts"))
+	result, err := q.q.GetActivityBoosts(ctx, guildID)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetActivityLeaderboardRankings(ctx context.Context, arg db.GetActivityLeaderboardRankingsParams) (db.GetActivityLeaderboardRankingsRow, error) {
+	ctx, span := Start(ctx, "db.GetActivityLeaderboardRankings", dbSystem, attribute.String("db.operation.name", "GetActivityLeaderboardRankings"))
+	result, err := q.q.GetActivityLeaderboardRankings(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetActivityRole(ctx context.Context, arg db.GetActivityRoleParams) (db.GetActivityRoleRow, error) {
+	ctx, span := Start(ctx, "db.GetActivityRole", dbSystem, attribute.String("db.operation.name", "GetActivityRole"))
+	result, err := q.q.GetActivityRole(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetActivityRoleResyncBatch(ctx context.Context, arg db.GetActivityRoleResyncBatchParams) ([]db.GetActivityRoleResyncBatchRow, error) {
+	ctx, span := Start(ctx, "db.GetActivityRoleResyncBatch", dbSystem, attribute.String("db.operation.name", "GetActivityRoleResyncBatch"))
+	result, err := q.q.GetActivityRoleResyncBatch(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetActivityRoleResyncJob(ctx context.Context, arg db.GetActivityRoleResyncJobParams) (db.GuildActivityRoleResyncJob, error) {
+	ctx, span := Start(ctx, "db.GetActivityRoleResyncJob", dbSystem, attribute.String("db.operation.name", "GetActivityRoleResyncJob"))
+	result, err := q.q.GetActivityRoleResyncJob(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetAllTimeActivityLeaderboard(ctx context.Context, arg db.GetAllTimeActivityLeaderboardParams) ([]db.GetAllTimeActivityLeaderboardRow, error) {
+	ctx, span := Start(ctx, "db.GetAllTimeActivityLeaderboard", dbSystem, attribute.String("db.operation.name", "GetAllTimeActivityLeaderboard"))
+	result, err := q.q.GetAllTimeActivityLeaderboard(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetAllTimeActivityLeaderboardPages(ctx context.Context, arg db.GetAllTimeActivityLeaderboardPagesParams) (int32, error) {
+	ctx, span := Start(ctx, "db.GetAllTimeActivityLeaderboardPages", dbSystem, attribute.String("db.operation.name", "GetAllTimeActivityLeaderboardPages"))
+	result, err := q.q.GetAllTimeActivityLeaderboardPages(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetArchivedMonthlyActivityLeaderboard(ctx context.Context, arg db.GetArchivedMonthlyActivityLeaderboardParams) ([]db.GetArchivedMonthlyActivityLeaderboardRow, error) {
+	ctx, span := Start(ctx, "db.GetArchivedMonthlyActivityLeaderboard", dbSystem, attribute.String("db.operation.name", "GetArchivedMonthlyActivityLeaderboard"))
+	result, err := q.q.GetArchivedMonthlyActivityLeaderboard(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetArchivedMonthlyActivityLeaderboardPages(ctx context.Context, arg db.GetArchivedMonthlyActivityLeaderboardPagesParams) (int32, error) {
+	ctx, span := Start(ctx, "db.GetArchivedMonthlyActivityLeaderboardPages", dbSystem, attribute.String("db.operation.name", "GetArchivedMonthlyActivityLeaderboardPages"))
+	result, err := q.q.GetArchivedMonthlyActivityLeaderboardPages(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetArchivedMonthlyActivityLeaderboardPeriods(ctx context.Context, arg db.GetArchivedMonthlyActivityLeaderboardPeriodsParams) ([]db.GetArchivedMonthlyActivityLeaderboardPeriodsRow, error) {
+	ctx, span := Start(ctx, "db.GetArchivedMonthlyActivityLeaderboardPeriods", dbSystem, attribute.String("db.operation.name", "GetArchivedMonthlyActivityLeaderboardPeriods"))
+	result, err := q.q.GetArchivedMonthlyActivityLeaderboardPeriods(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetArchivedWeeklyActivityLeaderboard(ctx context.Context, arg db.GetArchivedWeeklyActivityLeaderboardParams) ([]db.GetArchivedWeeklyActivityLeaderboardRow, error) {
+	ctx, span := Start(ctx, "db.GetArchivedWeeklyActivityLeaderboard", dbSystem, attribute.String("db.operation.name", "GetArchivedWeeklyActivityLeaderboard"))
+	result, err := q.q.GetArchivedWeeklyActivityLeaderboard(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetArchivedWeeklyActivityLeaderboardPages(ctx context.Context, arg db.GetArchivedWeeklyActivityLeaderboardPagesParams) (int32, error) {
+	ctx, span := Start(ctx, "db.GetArchivedWeeklyActivityLeaderboardPages", dbSystem, attribute.String("db.operation.name", "GetArchivedWeeklyActivityLeaderboardPages"))
+	result, err := q.q.GetArchivedWeeklyActivityLeaderboardPages(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetArchivedWeeklyActivityLeaderboardPeriods(ctx context.Context, arg db.GetArchivedWeeklyActivityLeaderboardPeriodsParams) ([]db.GetArchivedWeeklyActivityLeaderboardPeriodsRow, error) {
+	ctx, span := Start(ctx, "db.GetArchivedWeeklyActivityLeaderboardPeriods", dbSystem, attribute.String("db.operation.name", "GetArchivedWeeklyActivityLeaderboardPeriods"))
+	result, err := q.q.GetArchivedWeeklyActivityLeaderboardPeriods(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetChatActivityChannelMultipliers(ctx context.Context, guildID string) ([]db.GetChatActivityChannelMultipliersRow, error) {
+	ctx, span := Start(ctx, "db.GetChatActivityChannelMultipliers", dbSystem, attribute.String("db.operation.name", "GetChatActivityChannelMultipliers"))
+	result, err := q.q.GetChatActivityChannelMultipliers(ctx, guildID)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetGuildActivityRoles(ctx context.Context, arg db.GetGuildActivityRolesParams) ([]db.GetGuildActivityRolesRow, error) {
+	ctx, span := Start(ctx, "db.GetGuildActivityRoles", dbSystem, attribute.String("db.operation.name", "GetGuildActivityRoles"))
+	result, err := q.q.GetGuildActivityRoles(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetGuildAuditLogChannel(ctx context.Context, guildID string) (string, error) {
+	ctx, span := Start(ctx, "db.GetGuildAuditLogChannel", dbSystem, attribute.String("db.operation.name", "GetGuildAuditLogChannel"))
+	result, err := q.q.GetGuildAuditLogChannel(ctx, guildID)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetGuildChatActivitySettings(ctx context.Context, guildID string) (db.GetGuildChatActivitySettingsRow, error) {
+	ctx, span := Start(ctx, "db.GetGuildChatActivitySettings", dbSystem, attribute.String("db.operation.name", "GetGuildChatActivitySettings"))
+	result, err := q.q.GetGuildChatActivitySettings(ctx, guildID)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetGuildMessageEmbedSettings(ctx context.Context, guildID string) (db.GetGuildMessageEmbedSettingsRow, error) {
+	ctx, span := Start(ctx, "db.GetGuildMessageEmbedSettings", dbSystem, attribute.String("db.operation.name", "GetGuildMessageEmbedSettings"))
+	result, err := q.q.GetGuildMessageEmbedSettings(ctx, guildID)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetGuildVoiceActivitySettings(ctx context.Context, guildID string) (db.GetGuildVoiceActivitySettingsRow, error) {
+	ctx, span := Start(ctx, "db.GetGuildVoiceActivitySettings", dbSystem, attribute.String("db.operation.name", "GetGuildVoiceActivitySettings"))
+	result, err := q.q.GetGuildVoiceActivitySettings(ctx, guildID)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetMemberActivityLedger(ctx context.Context, arg db.GetMemberActivityLedgerParams) ([]db.GuildActivityLedger, error) {
+	ctx, span := Start(ctx, "db.GetMemberActivityLedger", dbSystem, attribute.String("db.operation.name", "GetMemberActivityLedger"))
+	result, err := q.q.GetMemberActivityLedger(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetMemberActivityPointsForUpdate(ctx context.Context, arg db.GetMemberActivityPointsForUpdateParams) (db.GetMemberActivityPointsForUpdateRow, error) {
+	ctx, span := Start(ctx, "db.GetMemberActivityPointsForUpdate", dbSystem, attribute.String("db.operation.name", "GetMemberActivityPointsForUpdate"))
+	result, err := q.q.GetMemberActivityPointsForUpdate(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetMemberActivityRoleInfo(ctx context.Context, arg db.GetMemberActivityRoleInfoParams) (db.GetMemberActivityRoleInfoRow, error) {
+	ctx, span := Start(ctx, "db.GetMemberActivityRoleInfo", dbSystem, attribute.String("db.operation.name", "GetMemberActivityRoleInfo"))
+	result, err := q.q.GetMemberActivityRoleInfo(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetMemberCurrentActivityPeriods(ctx context.Context, arg db.GetMemberCurrentActivityPeriodsParams) (db.GetMemberCurrentActivityPeriodsRow, error) {
+	ctx, span := Start(ctx, "db.GetMemberCurrentActivityPeriods", dbSystem, attribute.String("db.operation.name", "GetMemberCurrentActivityPeriods"))
+	result, err := q.q.GetMemberCurrentActivityPeriods(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetMemberMonthlyActivityHistory(ctx context.Context, arg db.GetMemberMonthlyActivityHistoryParams) ([]db.GetMemberMonthlyActivityHistoryRow, error) {
+	ctx, span := Start(ctx, "db.GetMemberMonthlyActivityHistory", dbSystem, attribute.String("db.operation.name", "GetMemberMonthlyActivityHistory"))
+	result, err := q.q.GetMemberMonthlyActivityHistory(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetMemberProfile(ctx context.Context, arg db.GetMemberProfileParams) (db.GetMemberProfileRow, error) {
+	ctx, span := Start(ctx, "db.GetMemberProfile", dbSystem, attribute.String("db.operation.name", "GetMemberProfile"))
+	result, err := q.q.GetMemberProfile(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetMemberWeeklyActivityHistory(ctx context.Context, arg db.GetMemberWeeklyActivityHistoryParams) ([]db.GetMemberWeeklyActivityHistoryRow, error) {
+	ctx, span := Start(ctx, "db.GetMemberWeeklyActivityHistory", dbSystem, attribute.String("db.operation.name", "GetMemberWeeklyActivityHistory"))
+	result, err := q.q.GetMemberWeeklyActivityHistory(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetMonthlyActivityLeaderboard(ctx context.Context, arg db.GetMonthlyActivityLeaderboardParams) ([]db.GetMonthlyActivityLeaderboardRow, error) {
+	ctx, span := Start(ctx, "db.GetMonthlyActivityLeaderboard", dbSystem, attribute.String("db.operation.name", "GetMonthlyActivityLeaderboard"))
+	result, err := q.q.GetMonthlyActivityLeaderboard(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetMonthlyActivityLeaderboardPages(ctx context.Context, arg db.GetMonthlyActivityLeaderboardPagesParams) (int32, error) {
+	ctx, span := Start(ctx, "db.GetMonthlyActivityLeaderboardPages", dbSystem, attribute.String("db.operation.name", "GetMonthlyActivityLeaderboardPages"))
+	result, err := q.q.GetMonthlyActivityLeaderboardPages(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetMonthlyActivityLeaderboardResetDetails(ctx context.Context) (db.GetMonthlyActivityLeaderboardResetDetailsRow, error) {
+	ctx, span := Start(ctx, "db.GetMonthlyActivityLeaderboardResetDetails", dbSystem, attribute.String("db.operation.name", "GetMonthlyActivityLeaderboardResetDetails"))
+	result, err := q.q.GetMonthlyActivityLeaderboardResetDetails(ctx)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetSettingsAuditLog(ctx context.Context, arg db.GetSettingsAuditLogParams) ([]db.GuildSettingsAuditLog, error) {
+	ctx, span := Start(ctx, "db.GetSettingsAuditLog", dbSystem, attribute.String("db.operation.name", "GetSettingsAuditLog"))
+	result, err := q.q.GetSettingsAuditLog(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetStaleVoiceSessions(ctx context.Context, before int32) ([]db.GuildVoiceSession, error) {
+	ctx, span := Start(ctx, "db.GetStaleVoiceSessions", dbSystem, attribute.String("db.operation.name", "GetStaleVoiceSessions"))
+	result, err := q.q.GetStaleVoiceSessions(ctx, before)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetVoiceRoom(ctx context.Context, arg db.GetVoiceRoomParams) (db.GuildActiveVoiceRoom, error) {
+	ctx, span := Start(ctx, "db.GetVoiceRoom", dbSystem, attribute.String("db.operation.name", "GetVoiceRoom"))
+	result, err := q.q.GetVoiceRoom(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetVoiceRoomIds(ctx context.Context, arg db.GetVoiceRoomIdsParams) ([]string, error) {
+	ctx, span := Start(ctx, "db.GetVoiceRoomIds", dbSystem, attribute.String("db.operation.name", "GetVoiceRoomIds"))
+	result, err := q.q.GetVoiceRoomIds(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetVoiceRoomLobbies(ctx context.Context, guildID string) ([]db.GetVoiceRoomLobbiesRow, error) {
+	ctx, span := Start(ctx, "db.GetVoiceRoomLobbies", dbSystem, attribute.String("db.operation.name", "GetVoiceRoomLobbies"))
+	result, err := q.q.GetVoiceRoomLobbies(ctx, guildID)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetVoiceRoomLobby(ctx context.Context, arg db.GetVoiceRoomLobbyParams) (db.GuildVoiceRoomsSetting, error) {
+	ctx, span := Start(ctx, "db.GetVoiceRoomLobby", dbSystem, attribute.String("db.operation.name", "GetVoiceRoomLobby"))
+	result, err := q.q.GetVoiceRoomLobby(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetVoiceRooms(ctx context.Context, arg db.GetVoiceRoomsParams) ([]db.GuildActiveVoiceRoom, error) {
+	ctx, span := Start(ctx, "db.GetVoiceRooms", dbSystem, attribute.String("db.operation.name", "GetVoiceRooms"))
+	result, err := q.q.GetVoiceRooms(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetVoiceSession(ctx context.Context, arg db.GetVoiceSessionParams) (db.GuildVoiceSession, error) {
+	ctx, span := Start(ctx, "db.GetVoiceSession", dbSystem, attribute.String("db.operation.name", "GetVoiceSession"))
+	result, err := q.q.GetVoiceSession(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetWeeklyActivityLeaderboard(ctx context.Context, arg db.GetWeeklyActivityLeaderboardParams) ([]db.GetWeeklyActivityLeaderboardRow, error) {
+	ctx, span := Start(ctx, "db.GetWeeklyActivityLeaderboard", dbSystem, attribute.String("db.operation.name", "GetWeeklyActivityLeaderboard"))
+	result, err := q.q.GetWeeklyActivityLeaderboard(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetWeeklyActivityLeaderboardPages(ctx context.Context, arg db.GetWeeklyActivityLeaderboardPagesParams) (int32, error) {
+	ctx, span := Start(ctx, "db.GetWeeklyActivityLeaderboardPages", dbSystem, attribute.String("db.operation.name", "GetWeeklyActivityLeaderboardPages"))
+	result, err := q.q.GetWeeklyActivityLeaderboardPages(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) GetWeeklyActivityLeaderboardResetDetails(ctx context.Context) (db.GetWeeklyActivityLeaderboardResetDetailsRow, error) {
+	ctx, span := Start(ctx, "db.GetWeeklyActivityLeaderboardResetDetails", dbSystem, attribute.String("db.operation.name", "GetWeeklyActivityLeaderboardResetDetails"))
+	result, err := q.q.GetWeeklyActivityLeaderboardResetDetails(ctx)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) IncrememberMemberChatActivityPoints(ctx context.Context, arg db.IncrememberMemberChatActivityPointsParams) (db.GuildProfile, error) {
+	ctx, span := Start(ctx, "db.IncrememberMemberChatActivityPoints", dbSystem, attribute.String("db.operation.name", "IncrememberMemberChatActivityPoints"))
+	result, err := q.q.IncrememberMemberChatActivityPoints(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) IncrementMemberVoiceActivityPoints(ctx context.Context, arg db.IncrementMemberVoiceActivityPointsParams) (db.GuildProfile, error) {
+	ctx, span := Start(ctx, "db.IncrementMemberVoiceActivityPoints", dbSystem, attribute.String("db.operation.name", "IncrementMemberVoiceActivityPoints"))
+	result, err := q.q.IncrementMemberVoiceActivityPoints(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) IncrementMonthlyActivityLeaderboard(ctx context.Context, arg db.IncrementMonthlyActivityLeaderboardParams) error {
+	ctx, span := Start(ctx, "db.IncrementMonthlyActivityLeaderboard", dbSystem, attribute.String("db.operation.name", "IncrementMonthlyActivityLeaderboard"))
+	err := q.q.IncrementMonthlyActivityLeaderboard(ctx, arg)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) IncrementWeeklyActivityLeaderboard(ctx context.Context, arg db.IncrementWeeklyActivityLeaderboardParams) error {
+	ctx, span := Start(ctx, "db.IncrementWeeklyActivityLeaderboard", dbSystem, attribute.String("db.operation.name", "IncrementWeeklyActivityLeaderboard"))
+	err := q.q.IncrementWeeklyActivityLeaderboard(ctx, arg)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) InsertActivityAdjustment(ctx context.Context, arg db.InsertActivityAdjustmentParams) (db.GuildActivityAdjustment, error) {
+	ctx, span := Start(ctx, "db.InsertActivityAdjustment", dbSystem, attribute.String("db.operation.name", "InsertActivityAdjustment"))
+	result, err := q.q.InsertActivityAdjustment(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) InsertActivityLedgerEntry(ctx context.Context, arg db.InsertActivityLedgerEntryParams) error {
+	ctx, span := Start(ctx, "db.InsertActivityLedgerEntry", dbSystem, attribute.String("db.operation.name", "InsertActivityLedgerEntry"))
+	err := q.q.InsertActivityLedgerEntry(ctx, arg)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) InsertActivityRole(ctx context.Context, arg db.InsertActivityRoleParams) error {
+	ctx, span := Start(ctx, "db.InsertActivityRole", dbSystem, attribute.String("db.operation.name", "InsertActivityRole"))
+	err := q.q.InsertActivityRole(ctx, arg)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) InsertSettingsAuditLogEntry(ctx context.Context, arg db.InsertSettingsAuditLogEntryParams) (db.GuildSettingsAuditLog, error) {
+	ctx, span := Start(ctx, "db.InsertSettingsAuditLogEntry", dbSystem, attribute.String("db.operation.name", "InsertSettingsAuditLogEntry"))
+	result, err := q.q.InsertSettingsAuditLogEntry(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) MigrateMemberProfile(ctx context.Context, arg db.MigrateMemberProfileParams) error {
+	ctx, span := Start(ctx, "db.MigrateMemberProfile", dbSystem, attribute.String("db.operation.name", "MigrateMemberProfile"))
+	err := q.q.MigrateMemberProfile(ctx, arg)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) OpenVoiceSession(ctx context.Context, arg db.OpenVoiceSessionParams) (db.GuildVoiceSession, error) {
+	ctx, span := Start(ctx, "db.OpenVoiceSession", dbSystem, attribute.String("db.operation.name", "OpenVoiceSession"))
+	result, err := q.q.OpenVoiceSession(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) RegisterGuild(ctx context.Context, guildID string) (db.Guild, error) {
+	ctx, span := Start(ctx, "db.RegisterGuild", dbSystem, attribute.String("db.operation.name", "RegisterGuild"))
+	result, err := q.q.RegisterGuild(ctx, guildID)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) RegisterVoiceRoom(ctx context.Context, arg db.RegisterVoiceRoomParams) (db.GuildActiveVoiceRoom, error) {
+	ctx, span := Start(ctx, "db.RegisterVoiceRoom", dbSystem, attribute.String("db.operation.name", "RegisterVoiceRoom"))
+	result, err := q.q.RegisterVoiceRoom(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) ReleaseActivityRoleResyncJob(ctx context.Context, jobID int32) error {
+	ctx, span := Start(ctx, "db.ReleaseActivityRoleResyncJob", dbSystem, attribute.String("db.operation.name", "ReleaseActivityRoleResyncJob"))
+	err := q.q.ReleaseActivityRoleResyncJob(ctx, jobID)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) RemoveGuildChatActivityDenyRole(ctx context.Context, arg db.RemoveGuildChatActivityDenyRoleParams) ([]string, error) {
+	ctx, span := Start(ctx, "db.RemoveGuildChatActivityDenyRole", dbSystem, attribute.String("db.operation.name", "RemoveGuildChatActivityDenyRole"))
+	result, err := q.q.RemoveGuildChatActivityDenyRole(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) RemoveGuildMessageEmbedSettingsArrays(ctx context.Context, arg db.RemoveGuildMessageEmbedSettingsArraysParams) error {
+	ctx, span := Start(ctx, "db.RemoveGuildMessageEmbedSettingsArrays", dbSystem, attribute.String("db.operation.name", "RemoveGuildMessageEmbedSettingsArrays"))
+	err := q.q.RemoveGuildMessageEmbedSettingsArrays(ctx, arg)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) RemoveGuildVoiceActivityDenyRole(ctx context.Context, arg db.RemoveGuildVoiceActivityDenyRoleParams) ([]string, error) {
+	ctx, span := Start(ctx, "db.RemoveGuildVoiceActivityDenyRole", dbSystem, attribute.String("db.operation.name", "RemoveGuildVoiceActivityDenyRole"))
+	result, err := q.q.RemoveGuildVoiceActivityDenyRole(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) ResetMemberProfile(ctx context.Context, arg db.ResetMemberProfileParams) error {
+	ctx, span := Start(ctx, "db.ResetMemberProfile", dbSystem, attribute.String("db.operation.name", "ResetMemberProfile"))
+	err := q.q.ResetMemberProfile(ctx, arg)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) ResolveChatActivityChannelMultiplier(ctx context.Context, arg db.ResolveChatActivityChannelMultiplierParams) (float32, error) {
+	ctx, span := Start(ctx, "db.ResolveChatActivityChannelMultiplier", dbSystem, attribute.String("db.operation.name", "ResolveChatActivityChannelMultiplier"))
+	result, err := q.q.ResolveChatActivityChannelMultiplier(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) SetMemberActivityPoints(ctx context.Context, arg db.SetMemberActivityPointsParams) error {
+	ctx, span := Start(ctx, "db.SetMemberActivityPoints", dbSystem, attribute.String("db.operation.name", "SetMemberActivityPoints"))
+	err := q.q.SetMemberActivityPoints(ctx, arg)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) StartActivityBoosts(ctx context.Context) ([]db.GuildActivityBoost, error) {
+	ctx, span := Start(ctx, "db.StartActivityBoosts", dbSystem, attribute.String("db.operation.name", "StartActivityBoosts"))
+	result, err := q.q.StartActivityBoosts(ctx)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) UpdateAPIKey(ctx context.Context, arg db.UpdateAPIKeyParams) (db.ApiKey, error) {
+	ctx, span := Start(ctx, "db.UpdateAPIKey", dbSystem, attribute.String("db.operation.name", "UpdateAPIKey"))
+	result, err := q.q.UpdateAPIKey(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) UpdateActivityBoost(ctx context.Context, arg db.UpdateActivityBoostParams) (db.GuildActivityBoost, error) {
+	ctx, span := Start(ctx, "db.UpdateActivityBoost", dbSystem, attribute.String("db.operation.name", "UpdateActivityBoost"))
+	result, err := q.q.UpdateActivityBoost(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) UpdateActivityRole(ctx context.Context, arg db.UpdateActivityRoleParams) (db.UpdateActivityRoleRow, error) {
+	ctx, span := Start(ctx, "db.UpdateActivityRole", dbSystem, attribute.String("db.operation.name", "UpdateActivityRole"))
+	result, err := q.q.UpdateActivityRole(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) UpdateActivityRoleResyncJobProgress(ctx context.Context, arg db.UpdateActivityRoleResyncJobProgressParams) (int64, error) {
+	ctx, span := Start(ctx, "db.UpdateActivityRoleResyncJobProgress", dbSystem, attribute.String("db.operation.name", "UpdateActivityRoleResyncJobProgress"))
+	result, err := q.q.UpdateActivityRoleResyncJobProgress(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) UpdateGuildAuditLogChannel(ctx context.Context, arg db.UpdateGuildAuditLogChannelParams) (string, error) {
+	ctx, span := Start(ctx, "db.UpdateGuildAuditLogChannel", dbSystem, attribute.String("db.operation.name", "UpdateGuildAuditLogChannel"))
+	result, err := q.q.UpdateGuildAuditLogChannel(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) UpdateGuildChatActivitySettings(ctx context.Context, arg db.UpdateGuildChatActivitySettingsParams) error {
+	ctx, span := Start(ctx, "db.UpdateGuildChatActivitySettings", dbSystem, attribute.String("db.operation.name", "UpdateGuildChatActivitySettings"))
+	err := q.q.UpdateGuildChatActivitySettings(ctx, arg)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) UpdateGuildMessageEmbedSettings(ctx context.Context, arg db.UpdateGuildMessageEmbedSettingsParams) error {
+	ctx, span := Start(ctx, "db.UpdateGuildMessageEmbedSettings", dbSystem, attribute.String("db.operation.name", "UpdateGuildMessageEmbedSettings"))
+	err := q.q.UpdateGuildMessageEmbedSettings(ctx, arg)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) UpdateGuildVoiceActivitySettings(ctx context.Context, arg db.UpdateGuildVoiceActivitySettingsParams) error {
+	ctx, span := Start(ctx, "db.UpdateGuildVoiceActivitySettings", dbSystem, attribute.String("db.operation.name", "UpdateGuildVoiceActivitySettings"))
+	err := q.q.UpdateGuildVoiceActivitySettings(ctx, arg)
+	End(span, err)
+
+	return err
+}
+
+func (q *Querier) UpdateVoiceRoom(ctx context.Context, arg db.UpdateVoiceRoomParams) (db.GuildActiveVoiceRoom, error) {
+	ctx, span := Start(ctx, "db.UpdateVoiceRoom", dbSystem, attribute.String("db.operation.name", "UpdateVoiceRoom"))
+	result, err := q.q.UpdateVoiceRoom(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) UpdateVoiceRoomLobby(ctx context.Context, arg db.UpdateVoiceRoomLobbyParams) (db.GuildVoiceRoomsSetting, error) {
+	ctx, span := Start(ctx, "db.UpdateVoiceRoomLobby", dbSystem, attribute.String("db.operation.name", "UpdateVoiceRoomLobby"))
+	result, err := q.q.UpdateVoiceRoomLobby(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) UpdateVoiceSession(ctx context.Context, arg db.UpdateVoiceSessionParams) (db.GuildVoiceSession, error) {
+	ctx, span := Start(ctx, "db.UpdateVoiceSession", dbSystem, attribute.String("db.operation.name", "UpdateVoiceSession"))
+	result, err := q.q.UpdateVoiceSession(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
+
+func (q *Querier) UpsertChatActivityChannelMultiplier(ctx context.Context, arg db.UpsertChatActivityChannelMultiplierParams) (db.UpsertChatActivityChannelMultiplierRow, error) {
+	ctx, span := Start(ctx, "db.UpsertChatActivityChannelMultiplier", dbSystem, attribute.String("db.operation.name", "UpsertChatActivityChannelMultiplier"))
+	result, err := q.q.UpsertChatActivityChannelMultiplier(ctx, arg)
+	End(span, err)
+
+	return result, err
+}
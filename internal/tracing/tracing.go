@@ -0,0 +1,79 @@
+package tracing
+
+// The querier and usecase wrappers are generated from their interfaces.
+//go:generate go run ./gen
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/typical-developers/discord-bot-backend"
+
+var tracer = otel.Tracer(instrumentationName)
+
+type Options struct {
+	// The name the service's spans are reported under.
+	ServiceName string
+
+	// The OTLP/HTTP endpoint to export spans to, i.e. "http://localhost:4318".
+	// When this is empty, the standard OTEL_EXPORTER_OTLP_* environment variables are used.
+	Endpoint string
+
+	// The fraction of traces to sample, between 0 and 1.
+	// Traces started by an incoming request follow the caller's sampling decision.
+	SampleRatio float64
+}
+
+// Setup registers the global tracer provider, exporting spans over OTLP/HTTP.
+// The returned function flushes any spans that haven't been exported and needs to be called on shutdown.
+//
+// Until this is called, every span is a no-op.
+func Setup(ctx context.Context, opts Options) (func(ctx context.Context) error, error) {
+	exporterOpts := []otlptracehttp.Option{}
+	if opts.Endpoint != "" {
+		exporterOpts = append(exporterOpts, otlptracehttp.WithEndpointURL(opts.Endpoint))
+	}
+
+	exporter, err := otlptracehttp.New(ctx, exporterOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", opts.ServiceName))),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return provider.Shutdown, nil
+}
+
+// Start starts a span as a child of the span in the context, if there is one.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records the error on the span, if there is one, then ends it.
+// Canceled requests and missing rows aren't treated as errors, since those are expected.
+func End(span trace.Span, err error) {
+	if err != nil && !errors.Is(err, context.Canceled) && !errors.Is(err, sql.ErrNoRows) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,484 @@
+// Code generated by internal/tracing/gen. DO NOT EDIT.
+
+package tracing
+
+import (
+	"context"
+
+	u "github.com/typical-developers/discord-bot-backend/internal/usecase"
+)
+
+type guildsUsecase struct {
+	uc u.GuildsUsecase
+}
+
+// NewGuildsUsecase wraps every usecase method in a span named after it.
+func NewGuildsUsecase(uc u.GuildsUsecase) u.GuildsUsecase {
+	return &guildsUsecase{uc: uc}
+}
+
+func (uc *guildsUsecase) RegisterGuild(ctx context.Context, guildId string) (*u.GuildSettings, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.RegisterGuild")
+	result, err := uc.uc.RegisterGuild(ctx, guildId)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) GetGuildSettings(ctx context.Context, guildId string) (*u.GuildSettings, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.GetGuildSettings")
+	result, err := uc.uc.GetGuildSettings(ctx, guildId)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) UpdateGuildActivitySettings(ctx context.Context, guildId string, opts u.UpdateAcitivtySettings) (*u.GuildSettings, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.UpdateGuildActivitySettings")
+	result, err := uc.uc.UpdateGuildActivitySettings(ctx, guildId, opts)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) GetActivityRoles(ctx context.Context, guildId string, activityType string) ([]u.GuildActivityRole, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.GetActivityRoles")
+	result, err := uc.uc.GetActivityRoles(ctx, guildId, activityType)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) GetActivityRole(ctx context.Context, guildId string, roleId string) (*u.GuildActivityRole, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.GetActivityRole")
+	result, err := uc.uc.GetActivityRole(ctx, guildId, roleId)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) CreateActivityRole(ctx context.Context, guildId string, activityType string, roleId string, requiredPoints int32) (*u.GuildActivityRole, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.CreateActivityRole")
+	result, err := uc.uc.CreateActivityRole(ctx, guildId, activityType, roleId, requiredPoints)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) UpdateActivityRole(ctx context.Context, guildId string, roleId string, opts u.UpdateActivityRoleOpts) (*u.GuildActivityRole, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.UpdateActivityRole")
+	result, err := uc.uc.UpdateActivityRole(ctx, guildId, roleId, opts)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) DeleteActivityRole(ctx context.Context, guildId string, roleId string) error {
+	ctx, span := Start(ctx, "GuildsUsecase.DeleteActivityRole")
+	err := uc.uc.DeleteActivityRole(ctx, guildId, roleId)
+	End(span, err)
+
+	return err
+}
+
+func (uc *guildsUsecase) EnqueueActivityRoleResync(ctx context.Context, guildId string) (*u.ActivityRoleResyncJob, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.EnqueueActivityRoleResync")
+	result, err := uc.uc.EnqueueActivityRoleResync(ctx, guildId)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) GetActivityRoleResyncJob(ctx context.Context, guildId string, jobId int32) (*u.ActivityRoleResyncJob, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.GetActivityRoleResyncJob")
+	result, err := uc.uc.GetActivityRoleResyncJob(ctx, guildId, jobId)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) GetActivityDenyRoles(ctx context.Context, guildId string, activityType string) ([]string, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.GetActivityDenyRoles")
+	result, err := uc.uc.GetActivityDenyRoles(ctx, guildId, activityType)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) AddActivityDenyRole(ctx context.Context, guildId string, activityType string, roleId string) ([]string, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.AddActivityDenyRole")
+	result, err := uc.uc.AddActivityDenyRole(ctx, guildId, activityType, roleId)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) RemoveActivityDenyRole(ctx context.Context, guildId string, activityType string, roleId string) ([]string, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.RemoveActivityDenyRole")
+	result, err := uc.uc.RemoveActivityDenyRole(ctx, guildId, activityType, roleId)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) GetChatActivityChannelMultipliers(ctx context.Context, guildId string) ([]u.GuildActivityChannelMultiplier, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.GetChatActivityChannelMultipliers")
+	result, err := uc.uc.GetChatActivityChannelMultipliers(ctx, guildId)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) SetChatActivityChannelMultiplier(ctx context.Context, guildId string, channelId string, multiplier float32) (*u.GuildActivityChannelMultiplier, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.SetChatActivityChannelMultiplier")
+	result, err := uc.uc.SetChatActivityChannelMultiplier(ctx, guildId, channelId, multiplier)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) DeleteChatActivityChannelMultiplier(ctx context.Context, guildId string, channelId string) error {
+	ctx, span := Start(ctx, "GuildsUsecase.DeleteChatActivityChannelMultiplier")
+	err := uc.uc.DeleteChatActivityChannelMultiplier(ctx, guildId, channelId)
+	End(span, err)
+
+	return err
+}
+
+func (uc *guildsUsecase) GetActivityBoosts(ctx context.Context, guildId string) ([]u.ActivityBoost, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.GetActivityBoosts")
+	result, err := uc.uc.GetActivityBoosts(ctx, guildId)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) GetActivityBoost(ctx context.Context, guildId string, boostId int32) (*u.ActivityBoost, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.GetActivityBoost")
+	result, err := uc.uc.GetActivityBoost(ctx, guildId, boostId)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) CreateActivityBoost(ctx context.Context, guildId string, opts u.CreateActivityBoostOpts) (*u.ActivityBoost, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.CreateActivityBoost")
+	result, err := uc.uc.CreateActivityBoost(ctx, guildId, opts)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) UpdateActivityBoost(ctx context.Context, guildId string, boostId int32, opts u.UpdateActivityBoostOpts) (*u.ActivityBoost, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.UpdateActivityBoost")
+	result, err := uc.uc.UpdateActivityBoost(ctx, guildId, boostId, opts)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) DeleteActivityBoost(ctx context.Context, guildId string, boostId int32) error {
+	ctx, span := Start(ctx, "GuildsUsecase.DeleteActivityBoost")
+	err := uc.uc.DeleteActivityBoost(ctx, guildId, boostId)
+	End(span, err)
+
+	return err
+}
+
+func (uc *guildsUsecase) UpdateMessageEmbedSettings(ctx context.Context, guildId string, opts u.UpdateMessageEmbedSettingsOpts) (*u.GuildSettings, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.UpdateMessageEmbedSettings")
+	result, err := uc.uc.UpdateMessageEmbedSettings(ctx, guildId, opts)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) GetSettingsAuditLog(ctx context.Context, guildId string, page int) (*u.GuildSettingsAuditLog, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.GetSettingsAuditLog")
+	result, err := uc.uc.GetSettingsAuditLog(ctx, guildId, page)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) UpdateAuditLogChannel(ctx context.Context, guildId string, channelId string) (*u.GuildSettings, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.UpdateAuditLogChannel")
+	result, err := uc.uc.UpdateAuditLogChannel(ctx, guildId, channelId)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) GetGuildActivityLeaderboardCardETag(ctx context.Context, guildId string, activityType, timePeriod string, page int, format string) (string, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.GetGuildActivityLeaderboardCardETag")
+	result, err := uc.uc.GetGuildActivityLeaderboardCardETag(ctx, guildId, activityType, timePeriod, page, format)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) GenerateGuildActivityLeaderboardCard(ctx context.Context, guildId string, activityType, timePeriod string, page int, format string) (*u.RenderedCard, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.GenerateGuildActivityLeaderboardCard")
+	result, err := uc.uc.GenerateGuildActivityLeaderboardCard(ctx, guildId, activityType, timePeriod, page, format)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) GetGuildActivityLeaderboardETag(ctx context.Context, referer string, guildId string, activityType, timePeriod, periodStart string, page int) (string, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.GetGuildActivityLeaderboardETag")
+	result, err := uc.uc.GetGuildActivityLeaderboardETag(ctx, referer, guildId, activityType, timePeriod, periodStart, page)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) GetGuildActivityLeaderboard(ctx context.Context, referer string, guildId string, activityType, timePeriod, periodStart string, page int) (*u.GuildLeaderboard, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.GetGuildActivityLeaderboard")
+	result, err := uc.uc.GetGuildActivityLeaderboard(ctx, referer, guildId, activityType, timePeriod, periodStart, page)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) GetArchivedLeaderboardPeriods(ctx context.Context, guildId string, activityType, timePeriod string) ([]u.ArchivedLeaderboardPeriod, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.GetArchivedLeaderboardPeriods")
+	result, err := uc.uc.GetArchivedLeaderboardPeriods(ctx, guildId, activityType, timePeriod)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) CreateVoiceRoomLobby(ctx context.Context, guildId string, originChannelId string, settings u.VoiceRoomLobbySettings) (*u.VoiceRoomLobby, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.CreateVoiceRoomLobby")
+	result, err := uc.uc.CreateVoiceRoomLobby(ctx, guildId, originChannelId, settings)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) GetVoiceRoomLobby(ctx context.Context, guildId string, originChannelId string) (*u.VoiceRoomLobby, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.GetVoiceRoomLobby")
+	result, err := uc.uc.GetVoiceRoomLobby(ctx, guildId, originChannelId)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) UpdateVoiceRoomLobby(ctx context.Context, guildId string, originChannelId string, settings u.VoiceRoomLobbySettings) (*u.VoiceRoomLobby, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.UpdateVoiceRoomLobby")
+	result, err := uc.uc.UpdateVoiceRoomLobby(ctx, guildId, originChannelId, settings)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) DeleteVoiceRoomLobby(ctx context.Context, guildId string, originChannelId string) error {
+	ctx, span := Start(ctx, "GuildsUsecase.DeleteVoiceRoomLobby")
+	err := uc.uc.DeleteVoiceRoomLobby(ctx, guildId, originChannelId)
+	End(span, err)
+
+	return err
+}
+
+func (uc *guildsUsecase) RegisterVoiceRoom(ctx context.Context, guildId string, originChannelId string, channelId string, creatorUserId string) (*u.VoiceRoom, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.RegisterVoiceRoom")
+	result, err := uc.uc.RegisterVoiceRoom(ctx, guildId, originChannelId, channelId, creatorUserId)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) GetVoiceRoom(ctx context.Context, guildId string, channelId string) (*u.VoiceRoom, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.GetVoiceRoom")
+	result, err := uc.uc.GetVoiceRoom(ctx, guildId, channelId)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) UpdateVoiceRoom(ctx context.Context, guildId string, channelId string, opts u.VoiceRoomModify) (*u.VoiceRoom, error) {
+	ctx, span := Start(ctx, "GuildsUsecase.UpdateVoiceRoom")
+	result, err := uc.uc.UpdateVoiceRoom(ctx, guildId, channelId, opts)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *guildsUsecase) DeleteVoiceRoom(ctx context.Context, guildId string, channelId string) error {
+	ctx, span := Start(ctx, "GuildsUsecase.DeleteVoiceRoom")
+	err := uc.uc.DeleteVoiceRoom(ctx, guildId, channelId)
+	End(span, err)
+
+	return err
+}
+
+type memberUsecase struct {
+	uc u.MemberUsecase
+}
+
+// NewMemberUsecase wraps every usecase method in a span named after it.
+func NewMemberUsecase(uc u.MemberUsecase) u.MemberUsecase {
+	return &memberUsecase{uc: uc}
+}
+
+func (uc *memberUsecase) CreateMemberProfile(ctx context.Context, guildId string, userId string) (*u.MemberProfile, error) {
+	ctx, span := Start(ctx, "MemberUsecase.CreateMemberProfile")
+	result, err := uc.uc.CreateMemberProfile(ctx, guildId, userId)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *memberUsecase) GetMemberProfile(ctx context.Context, guildId string, userId string) (*u.MemberProfile, error) {
+	ctx, span := Start(ctx, "MemberUsecase.GetMemberProfile")
+	result, err := uc.uc.GetMemberProfile(ctx, guildId, userId)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *memberUsecase) GetMemberActivityHistory(ctx context.Context, guildId string, userId string, activityType string, weeks, months int) (*u.MemberActivityHistory, error) {
+	ctx, span := Start(ctx, "MemberUsecase.GetMemberActivityHistory")
+	result, err := uc.uc.GetMemberActivityHistory(ctx, guildId, userId, activityType, weeks, months)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *memberUsecase) GetMemberActivityLedger(ctx context.Context, guildId string, userId string, activityType string, page int) (*u.MemberActivityLedger, error) {
+	ctx, span := Start(ctx, "MemberUsecase.GetMemberActivityLedger")
+	result, err := uc.uc.GetMemberActivityLedger(ctx, guildId, userId, activityType, page)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *memberUsecase) IncrementMemberChatActivityPoints(ctx context.Context, guildId string, userId string, channelId string, createProfile *bool) (*u.ActivityGrant, error) {
+	ctx, span := Start(ctx, "MemberUsecase.IncrementMemberChatActivityPoints")
+	result, err := uc.uc.IncrementMemberChatActivityPoints(ctx, guildId, userId, channelId, createProfile)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *memberUsecase) IncrementMemberVoiceActivityPoints(ctx context.Context, guildId string, userId string, createProfile *bool) (*u.ActivityGrant, error) {
+	ctx, span := Start(ctx, "MemberUsecase.IncrementMemberVoiceActivityPoints")
+	result, err := uc.uc.IncrementMemberVoiceActivityPoints(ctx, guildId, userId, createProfile)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *memberUsecase) GetMemberVoiceSession(ctx context.Context, guildId string, userId string) (*u.VoiceSession, error) {
+	ctx, span := Start(ctx, "MemberUsecase.GetMemberVoiceSession")
+	result, err := uc.uc.GetMemberVoiceSession(ctx, guildId, userId)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *memberUsecase) OpenMemberVoiceSession(ctx context.Context, guildId string, userId string, state u.VoiceSessionState, createProfile *bool) (*u.VoiceSession, error) {
+	ctx, span := Start(ctx, "MemberUsecase.OpenMemberVoiceSession")
+	result, err := uc.uc.OpenMemberVoiceSession(ctx, guildId, userId, state, createProfile)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *memberUsecase) UpdateMemberVoiceSession(ctx context.Context, guildId string, userId string, state u.VoiceSessionState) (*u.VoiceSession, error) {
+	ctx, span := Start(ctx, "MemberUsecase.UpdateMemberVoiceSession")
+	result, err := uc.uc.UpdateMemberVoiceSession(ctx, guildId, userId, state)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *memberUsecase) CloseMemberVoiceSession(ctx context.Context, guildId string, userId string) (*u.ClosedVoiceSession, error) {
+	ctx, span := Start(ctx, "MemberUsecase.CloseMemberVoiceSession")
+	result, err := uc.uc.CloseMemberVoiceSession(ctx, guildId, userId)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *memberUsecase) GetMemberProfileCardETag(ctx context.Context, guildId string, userId string, format string) (string, error) {
+	ctx, span := Start(ctx, "MemberUsecase.GetMemberProfileCardETag")
+	result, err := uc.uc.GetMemberProfileCardETag(ctx, guildId, userId, format)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *memberUsecase) GenerateMemberProfileCard(ctx context.Context, guildId string, userId string, format string) (*u.RenderedCard, error) {
+	ctx, span := Start(ctx, "MemberUsecase.GenerateMemberProfileCard")
+	result, err := uc.uc.GenerateMemberProfileCard(ctx, guildId, userId, format)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *memberUsecase) MigrateMemberProfile(ctx context.Context, guildId string, userId string, toUserId string) error {
+	ctx, span := Start(ctx, "MemberUsecase.MigrateMemberProfile")
+	err := uc.uc.MigrateMemberProfile(ctx, guildId, userId, toUserId)
+	End(span, err)
+
+	return err
+}
+
+func (uc *memberUsecase) AdjustMemberActivityPoints(ctx context.Context, guildId string, opts u.AdjustActivityPointsOpts) ([]u.ActivityAdjustment, error) {
+	ctx, span := Start(ctx, "MemberUsecase.AdjustMemberActivityPoints")
+	result, err := uc.uc.AdjustMemberActivityPoints(ctx, guildId, opts)
+	End(span, err)
+
+	return result, err
+}
+
+type authUsecase struct {
+	uc u.AuthUsecase
+}
+
+// NewAuthUsecase wraps every usecase method in a span named after it.
+func NewAuthUsecase(uc u.AuthUsecase) u.AuthUsecase {
+	return &authUsecase{uc: uc}
+}
+
+func (uc *authUsecase) Authenticate(ctx context.Context, key string) (*u.APIKey, error) {
+	ctx, span := Start(ctx, "AuthUsecase.Authenticate")
+	result, err := uc.uc.Authenticate(ctx, key)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *authUsecase) CreateAPIKey(ctx context.Context, opts u.CreateAPIKeyOpts) (*u.CreatedAPIKey, error) {
+	ctx, span := Start(ctx, "AuthUsecase.CreateAPIKey")
+	result, err := uc.uc.CreateAPIKey(ctx, opts)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *authUsecase) GetAPIKeys(ctx context.Context) ([]u.APIKey, error) {
+	ctx, span := Start(ctx, "AuthUsecase.GetAPIKeys")
+	result, err := uc.uc.GetAPIKeys(ctx)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *authUsecase) UpdateAPIKey(ctx context.Context, keyId string, opts u.UpdateAPIKeyOpts) (*u.APIKey, error) {
+	ctx, span := Start(ctx, "AuthUsecase.UpdateAPIKey")
+	result, err := uc.uc.UpdateAPIKey(ctx, keyId, opts)
+	End(span, err)
+
+	return result, err
+}
+
+func (uc *authUsecase) DeleteAPIKey(ctx context.Context, keyId string) error {
+	ctx, span := Start(ctx, "AuthUsecase.DeleteAPIKey")
+	err := uc.uc.DeleteAPIKey(ctx, keyId)
+	End(span, err)
+
+	return err
+}
@@ -0,0 +1,12 @@
+package usecase
+
+import "context"
+
+type AuthUsecase interface {
+	Authenticate(ctx context.Context, key string) (*APIKey, error)
+
+	CreateAPIKey(ctx context.Context, opts CreateAPIKeyOpts) (*CreatedAPIKey, error)
+	GetAPIKeys(ctx context.Context) ([]APIKey, error)
+	UpdateAPIKey(ctx context.Context, keyId string, opts UpdateAPIKeyOpts) (*APIKey, error)
+	DeleteAPIKey(ctx context.Context, keyId string) error
+}
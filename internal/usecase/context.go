@@ -0,0 +1,19 @@
+package usecase
+
+import "context"
+
+type contextKey string
+
+const actorIdContextKey contextKey = "actor_id"
+
+// WithActorID attaches the ID of the user that's making the request to the context.
+// Changes to guild settings made with the context are attributed to them.
+func WithActorID(ctx context.Context, actorId string) context.Context {
+	return context.WithValue(ctx, actorIdContextKey, actorId)
+}
+
+// ActorID gets the actor ID attached to the context, this is empty when there isn't one.
+func ActorID(ctx context.Context) string {
+	actorId, _ := ctx.Value(actorIdContextKey).(string)
+	return actorId
+}
@@ -13,21 +13,65 @@ func (e UsecaseError) Error() string {
 	return e.Message
 }
 
+// CooldownError is ErrMemberOnGrantCooldown with how many seconds are left on the member's cooldown.
+type CooldownError struct {
+	UsecaseError
+	RemainingSeconds int32
+}
+
+func NewCooldownError(remainingSeconds int32) CooldownError {
+	return CooldownError{UsecaseError: ErrMemberOnGrantCooldown, RemainingSeconds: remainingSeconds}
+}
+
+func (e CooldownError) Unwrap() error {
+	return e.UsecaseError
+}
+
 var (
 	// Guild Setting Errors
-	ErrGuildSettingsExists          = NewUsecaseError("GUILD_ALREADY_EXISTS", "the guild already exists.")
-	ErrGuildNotFound                = NewUsecaseError("GUILD_NOT_FOUND", "the guild was not found.")
-	ErrChatActivityTrackingDisabled = NewUsecaseError("CHAT_ACTIVITY_TRACKING_DISABLED", "chat activity tracking is disabled.")
-	ErrActivityRoleExists           = NewUsecaseError("ACTIVITY_ROLE_ALREADY_EXISTS", "the activity role already exists.")
+	ErrGuildSettingsExists            = NewUsecaseError("GUILD_ALREADY_EXISTS", "the guild already exists.")
+	ErrGuildNotFound                  = NewUsecaseError("GUILD_NOT_FOUND", "the guild was not found.")
+	ErrChatActivityTrackingDisabled   = NewUsecaseError("CHAT_ACTIVITY_TRACKING_DISABLED", "chat activity tracking is disabled.")
+	ErrVoiceActivityTrackingDisabled  = NewUsecaseError("VOICE_ACTIVITY_TRACKING_DISABLED", "voice activity tracking is disabled.")
+	ErrActivityRoleExists             = NewUsecaseError("ACTIVITY_ROLE_ALREADY_EXISTS", "the activity role already exists.")
+	ErrActivityRoleNotFound           = NewUsecaseError("ACTIVITY_ROLE_NOT_FOUND", "the activity role was not found.")
+	ErrActivityRoleDuplicateThreshold = NewUsecaseError("ACTIVITY_ROLE_DUPLICATE_THRESHOLD", "another activity role already uses the required points.")
+	ErrActivityDenyRoleNotFound       = NewUsecaseError("ACTIVITY_DENY_ROLE_NOT_FOUND", "the role is not in the activity deny list.")
+	ErrInvalidActivityType            = NewUsecaseError("INVALID_ACTIVITY_TYPE", "the activity type must be chat or voice.")
+	ErrGuildRoleNotFound              = NewUsecaseError("GUILD_ROLE_NOT_FOUND", "the role does not exist in the guild.")
+	ErrChannelMultiplierNotFound      = NewUsecaseError("CHANNEL_MULTIPLIER_NOT_FOUND", "the channel does not have an activity multiplier.")
+	ErrChannelActivityExcluded        = NewUsecaseError("CHANNEL_ACTIVITY_EXCLUDED", "the channel is excluded from earning activity points.")
+	ErrActivityBoostNotFound          = NewUsecaseError("ACTIVITY_BOOST_NOT_FOUND", "the activity boost was not found.")
+	ErrInvalidActivityBoost           = NewUsecaseError("INVALID_ACTIVITY_BOOST", "the activity boost must end after it starts and have a multiplier above 0.")
+	ErrActivityRoleResyncJobExists    = NewUsecaseError("ACTIVITY_ROLE_RESYNC_JOB_EXISTS", "an activity role resync is already queued for the guild.")
+	ErrActivityRoleResyncJobNotFound  = NewUsecaseError("ACTIVITY_ROLE_RESYNC_JOB_NOT_FOUND", "the activity role resync job was not found.")
+	ErrInvalidActorID                 = NewUsecaseError("INVALID_ACTOR_ID", "the X-Actor-ID header must be a user ID.")
+	ErrAuditLogChannelNotFound        = NewUsecaseError("AUDIT_LOG_CHANNEL_NOT_FOUND", "the audit log channel does not exist in the guild.")
 
 	// Member Errors
 	ErrMemberNotInGuild      = NewUsecaseError("MEMBER_NOT_IN_GUILD", "the member is not in the guild.")
 	ErrMemberProfileNotFound = NewUsecaseError("MEMBER_NOT_FOUND", "the member profile was not found.")
 	ErrMemberProfileExists   = NewUsecaseError("MEMBER_ALREADY_EXISTS", "the member profile already exists.")
 	ErrMemberOnGrantCooldown = NewUsecaseError("MEMBER_ON_COOLDOWN", "the member is on cooldown.")
+	ErrMemberGrantDenied     = NewUsecaseError("MEMBER_GRANT_DENIED", "the member has a role that is denied from earning activity points.")
+
+	// Activity Adjustment Errors
+	ErrInvalidActivityAdjustment = NewUsecaseError("INVALID_ACTIVITY_ADJUSTMENT", "the operation must be add, subtract or set, and each member can only be adjusted once by 0 or more points.")
+	ErrActivityAdjustmentNoActor = NewUsecaseError("ACTIVITY_ADJUSTMENT_NO_ACTOR", "the X-Actor-ID header is required to adjust activity points.")
+
+	// Voice Session Errors
+	ErrVoiceSessionExists   = NewUsecaseError("VOICE_SESSION_ALREADY_EXISTS", "the member already has an open voice session.")
+	ErrVoiceSessionNotFound = NewUsecaseError("VOICE_SESSION_NOT_FOUND", "the member does not have an open voice session.")
 
 	// Leaderboard Errors
-	ErrLeaderboardNoRows = NewUsecaseError("LEADERBOARD_NO_ROWS", "the leaderboard has no rows.")
+	ErrLeaderboardNoRows           = NewUsecaseError("LEADERBOARD_NO_ROWS", "the leaderboard has no rows.")
+	ErrInvalidLeaderboardPeriod    = NewUsecaseError("INVALID_LEADERBOARD_PERIOD", "the leaderboard period is invalid.")
+	ErrInvalidLeaderboardTimeframe = NewUsecaseError("INVALID_LEADERBOARD_TIME_PERIOD", "the time period must be weekly or monthly.")
+
+	// Card Errors
+	ErrInvalidCardFormat     = NewUsecaseError("INVALID_CARD_FORMAT", "the card format must be html, png or webp.")
+	ErrUnsupportedCardFormat = NewUsecaseError("UNSUPPORTED_CARD_FORMAT", "the card renderer does not support the format.")
+	ErrCardRenderingDisabled = NewUsecaseError("CARD_RENDERING_DISABLED", "rendering cards to images is disabled.")
 
 	// Voice Room Errors
 	ErrVoiceRoomLobbyExists      = NewUsecaseError("VOICE_ROOM_LOBBY_ALREADY_EXISTS", "the voice room lobby already exists.")
@@ -35,4 +79,15 @@ var (
 	ErrVoiceRoomLobbyIsVoiceRoom = NewUsecaseError("VOICE_ROOM_LOBBY_IS_ACTIVE_VOICE_ROOM", "the voice room lobby is already an active voice room.")
 	ErrVoiceRoomExists           = NewUsecaseError("VOICE_ROOM_EXISTS", "the voice room already exists.")
 	ErrVoiceRoomNotFound         = NewUsecaseError("VOICE_ROOM_NOT_FOUND", "the voice room was not found.")
+
+	// API Key Errors
+	ErrAPIKeyMissing           = NewUsecaseError("API_KEY_MISSING", "the X-API-KEY header is required.")
+	ErrAPIKeyInvalid           = NewUsecaseError("API_KEY_INVALID", "the api key is invalid.")
+	ErrAPIKeyExpired           = NewUsecaseError("API_KEY_EXPIRED", "the api key has expired.")
+	ErrAPIKeyInsufficientScope = NewUsecaseError("API_KEY_INSUFFICIENT_SCOPE", "the api key does not have the required scope.")
+	ErrAPIKeyNotFound          = NewUsecaseError("API_KEY_NOT_FOUND", "the api key was not found.")
+	ErrAPIKeyInvalidScope      = NewUsecaseError("API_KEY_INVALID_SCOPE", "the api key scope is not valid.")
+
+	// Rate Limit Errors
+	ErrRateLimited = NewUsecaseError("RATE_LIMITED", "too many requests, try again later.")
 )
@@ -2,8 +2,6 @@ package usecase
 
 import (
 	"context"
-
-	"maragu.dev/gomponents"
 )
 
 type GuildsUsecase interface {
@@ -11,13 +9,40 @@ type GuildsUsecase interface {
 	GetGuildSettings(ctx context.Context, guildId string) (*GuildSettings, error)
 
 	UpdateGuildActivitySettings(ctx context.Context, guildId string, opts UpdateAcitivtySettings) (*GuildSettings, error)
+	GetActivityRoles(ctx context.Context, guildId string, activityType string) ([]GuildActivityRole, error)
+	GetActivityRole(ctx context.Context, guildId string, roleId string) (*GuildActivityRole, error)
 	CreateActivityRole(ctx context.Context, guildId string, activityType string, roleId string, requiredPoints int32) (*GuildActivityRole, error)
+	UpdateActivityRole(ctx context.Context, guildId string, roleId string, opts UpdateActivityRoleOpts) (*GuildActivityRole, error)
 	DeleteActivityRole(ctx context.Context, guildId string, roleId string) error
+	EnqueueActivityRoleResync(ctx context.Context, guildId string) (*ActivityRoleResyncJob, error)
+	GetActivityRoleResyncJob(ctx context.Context, guildId string, jobId int32) (*ActivityRoleResyncJob, error)
+
+	GetActivityDenyRoles(ctx context.Context, guildId string, activityType string) ([]string, error)
+	AddActivityDenyRole(ctx context.Context, guildId string, activityType string, roleId string) ([]string, error)
+	RemoveActivityDenyRole(ctx context.Context, guildId string, activityType string, roleId string) ([]string, error)
+
+	GetChatActivityChannelMultipliers(ctx context.Context, guildId string) ([]GuildActivityChannelMultiplier, error)
+	SetChatActivityChannelMultiplier(ctx context.Context, guildId string, channelId string, multiplier float32) (*GuildActivityChannelMultiplier, error)
+	DeleteChatActivityChannelMultiplier(ctx context.Context, guildId string, channelId string) error
+
+	GetActivityBoosts(ctx context.Context, guildId string) ([]ActivityBoost, error)
+	GetActivityBoost(ctx context.Context, guildId string, boostId int32) (*ActivityBoost, error)
+	CreateActivityBoost(ctx context.Context, guildId string, opts CreateActivityBoostOpts) (*ActivityBoost, error)
+	UpdateActivityBoost(ctx context.Context, guildId string, boostId int32, opts UpdateActivityBoostOpts) (*ActivityBoost, error)
+	DeleteActivityBoost(ctx context.Context, guildId string, boostId int32) error
 
 	UpdateMessageEmbedSettings(ctx context.Context, guildId string, opts UpdateMessageEmbedSettingsOpts) (*GuildSettings, error)
 
-	GenerateGuildActivityLeaderboardCard(ctx context.Context, guildId string, acitivtyType, timePeriod string, page int) (gomponents.Node, error)
-	GetGuildActivityLeaderboard(ctx context.Context, referer string, guildId string, activityType, timePeriod string, page int) (*GuildLeaderboard, error)
+	// Settings changes are attributed to the actor attached to the context with WithActorID.
+	GetSettingsAuditLog(ctx context.Context, guildId string, page int) (*GuildSettingsAuditLog, error)
+	UpdateAuditLogChannel(ctx context.Context, guildId string, channelId string) (*GuildSettings, error)
+
+	// The ETags are empty when the cards' data version isn't available.
+	GetGuildActivityLeaderboardCardETag(ctx context.Context, guildId string, activityType, timePeriod string, page int, format string) (string, error)
+	GenerateGuildActivityLeaderboardCard(ctx context.Context, guildId string, activityType, timePeriod string, page int, format string) (*RenderedCard, error)
+	GetGuildActivityLeaderboardETag(ctx context.Context, referer string, guildId string, activityType, timePeriod, periodStart string, page int) (string, error)
+	GetGuildActivityLeaderboard(ctx context.Context, referer string, guildId string, activityType, timePeriod, periodStart string, page int) (*GuildLeaderboard, error)
+	GetArchivedLeaderboardPeriods(ctx context.Context, guildId string, activityType, timePeriod string) ([]ArchivedLeaderboardPeriod, error)
 
 	CreateVoiceRoomLobby(ctx context.Context, guildId string, originChannelId string, settings VoiceRoomLobbySettings) (*VoiceRoomLobby, error)
 	GetVoiceRoomLobby(ctx context.Context, guildId string, originChannelId string) (*VoiceRoomLobby, error)
@@ -2,14 +2,28 @@ package usecase
 
 import (
 	"context"
-
-	"maragu.dev/gomponents"
 )
 
 type MemberUsecase interface {
 	CreateMemberProfile(ctx context.Context, guildId string, userId string) (*MemberProfile, error)
 	GetMemberProfile(ctx context.Context, guildId string, userId string) (*MemberProfile, error)
-	IncrementMemberChatActivityPoints(ctx context.Context, guildId string, userId string) (*MemberProfile, error)
-	GenerateMemberProfileCard(ctx context.Context, guildId string, userId string) (gomponents.Node, error)
+	GetMemberActivityHistory(ctx context.Context, guildId string, userId string, activityType string, weeks, months int) (*MemberActivityHistory, error)
+	// The activity type is optional, every activity type is included when it's empty.
+	GetMemberActivityLedger(ctx context.Context, guildId string, userId string, activityType string, page int) (*MemberActivityLedger, error)
+	// When chat activity grants are buffered, the grant is only queued and no profile is returned.
+	// createProfile overrides the guild's auto_create_profiles setting when it isn't nil.
+	IncrementMemberChatActivityPoints(ctx context.Context, guildId string, userId string, channelId string, createProfile *bool) (*ActivityGrant, error)
+	IncrementMemberVoiceActivityPoints(ctx context.Context, guildId string, userId string, createProfile *bool) (*ActivityGrant, error)
+	GetMemberVoiceSession(ctx context.Context, guildId string, userId string) (*VoiceSession, error)
+	// createProfile overrides the guild's auto_create_profiles setting when it isn't nil.
+	OpenMemberVoiceSession(ctx context.Context, guildId string, userId string, state VoiceSessionState, createProfile *bool) (*VoiceSession, error)
+	UpdateMemberVoiceSession(ctx context.Context, guildId string, userId string, state VoiceSessionState) (*VoiceSession, error)
+	CloseMemberVoiceSession(ctx context.Context, guildId string, userId string) (*ClosedVoiceSession, error)
+	// The ETag is empty when the card's data version isn't available.
+	GetMemberProfileCardETag(ctx context.Context, guildId string, userId string, format string) (string, error)
+	GenerateMemberProfileCard(ctx context.Context, guildId string, userId string, format string) (*RenderedCard, error)
 	MigrateMemberProfile(ctx context.Context, guildId string, userId string, toUserId string) error
+	// Every member is adjusted in the same transaction, so none of them are adjusted when one fails.
+	// The adjustments are attributed to the actor attached to the context with WithActorID, which is required.
+	AdjustMemberActivityPoints(ctx context.Context, guildId string, opts AdjustActivityPointsOpts) ([]ActivityAdjustment, error)
 }
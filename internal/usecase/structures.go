@@ -1,16 +1,43 @@
 package usecase
 
+import (
+	"encoding/json"
+	"slices"
+)
+
 type GuildActivityRole struct {
 	RoleID         string `json:"role_id"`
+	ActivityType   string `json:"activity_type"`
 	RequiredPoints int32  `json:"required_points"`
 }
 
+type UpdateActivityRoleOpts struct {
+	ActivityType   *string `json:"activity_type"`
+	RequiredPoints *int32  `json:"required_points"`
+}
+
 type GuildActivityTracking struct {
 	IsEnabled       bool                `json:"is_enabled"`
 	GrantAmount     int32               `json:"grant_amount"`
 	CooldownSeconds int32               `json:"cooldown"`
 	ActivityRoles   []GuildActivityRole `json:"activity_roles"`
 	DenyRoles       []string            `json:"deny_roles"`
+
+	// When enabled, earned activity roles are assigned to the member after a successful grant.
+	// The mode is either "stack" (keep every earned role) or "replace" (only keep the highest role).
+	AutoAssignRoles    bool   `json:"auto_assign_roles"`
+	RoleAssignmentMode string `json:"role_assignment_mode"`
+
+	// When enabled, grants create the member's profile if it doesn't exist yet.
+	AutoCreateProfiles bool `json:"auto_create_profiles"`
+
+	ChannelMultipliers []GuildActivityChannelMultiplier `json:"channel_multipliers,omitempty"`
+}
+
+// A multiplier of 0 excludes the channel from earning activity points.
+type GuildActivityChannelMultiplier struct {
+	ChannelID  string  `json:"channel_id"`
+	Multiplier float32 `json:"multiplier"`
 }
 
 type VoiceRoomLobby struct {
@@ -31,15 +58,91 @@ type MessageEmbeds struct {
 }
 
 type GuildSettings struct {
-	ChatActivityTracking GuildActivityTracking `json:"chat_activity"`
-	MessageEmbeds        MessageEmbeds         `json:"message_embeds"`
-	VoiceRoomLobbies     []VoiceRoomLobby      `json:"voice_room_lobbies"`
+	ChatActivityTracking  GuildActivityTracking `json:"chat_activity"`
+	VoiceActivityTracking GuildActivityTracking `json:"voice_activity"`
+	MessageEmbeds         MessageEmbeds         `json:"message_embeds"`
+	VoiceRoomLobbies      []VoiceRoomLobby      `json:"voice_room_lobbies"`
+	ActivityBoosts        []ActivityBoost       `json:"activity_boosts"`
+
+	// The channel that settings changes are posted to, this is empty when they aren't posted anywhere.
+	AuditLogChannelID string `json:"audit_log_channel_id"`
+}
+
+// Before and After only hold the fields that changed.
+// Before is empty when the setting was created, After is empty when it was deleted.
+type SettingsAuditLogEntry struct {
+	EntryID   int64           `json:"entry_id"`
+	ActorID   string          `json:"actor_id,omitempty"`
+	Action    string          `json:"action"`
+	Target    string          `json:"target,omitempty"`
+	Before    json.RawMessage `json:"before" swaggertype:"object"`
+	After     json.RawMessage `json:"after" swaggertype:"object"`
+	CreatedAt int64           `json:"created_at"`
+}
+
+// Entries are ordered from newest to oldest.
+type GuildSettingsAuditLog struct {
+	CurrentPage int32 `json:"current_page"`
+	TotalPages  int32 `json:"total_pages"`
+	HasNextPage bool  `json:"has_next_page"`
+
+	Entries []SettingsAuditLogEntry `json:"entries"`
+}
+
+// When role IDs are set, only members with at least one of the roles are boosted.
+type ActivityBoost struct {
+	BoostID      int32    `json:"boost_id"`
+	Name         string   `json:"name"`
+	ActivityType string   `json:"activity_type"`
+	Multiplier   float32  `json:"multiplier"`
+	RoleIDs      []string `json:"role_ids"`
+	StartsAt     int64    `json:"starts_at"`
+	EndsAt       int64    `json:"ends_at"`
+	IsActive     bool     `json:"is_active"`
+}
+
+// The status is one of "pending", "running", "completed" or "failed".
+// The failed profiles are members whose roles couldn't be synced, they're also counted as processed.
+type ActivityRoleResyncJob struct {
+	JobID             int32  `json:"job_id"`
+	Status            string `json:"status"`
+	TotalProfiles     int32  `json:"total_profiles"`
+	ProcessedProfiles int32  `json:"processed_profiles"`
+	FailedProfiles    int32  `json:"failed_profiles"`
+	RolesAdded        int32  `json:"roles_added"`
+	RolesRemoved      int32  `json:"roles_removed"`
+	Error             string `json:"error,omitempty"`
+	CreatedAt         int64  `json:"created_at"`
+	StartedAt         *int64 `json:"started_at"`
+	FinishedAt        *int64 `json:"finished_at"`
+}
+
+type CreateActivityBoostOpts struct {
+	Name         string   `json:"name"`
+	ActivityType string   `json:"activity_type"`
+	Multiplier   float32  `json:"multiplier"`
+	RoleIDs      []string `json:"role_ids"`
+	StartsAt     int32    `json:"starts_at"`
+	EndsAt       int32    `json:"ends_at"`
+}
+
+type UpdateActivityBoostOpts struct {
+	Name       *string  `json:"name"`
+	Multiplier *float32 `json:"multiplier"`
+	RoleIDs    []string `json:"role_ids"`
+	StartsAt   *int32   `json:"starts_at"`
+	EndsAt     *int32   `json:"ends_at"`
 }
 
 type UpdateActivitySettingsOpts struct {
 	IsEnabled       *bool  `json:"is_enabled"`
 	GrantAmount     *int32 `json:"grant_amount"`
 	CooldownSeconds *int32 `json:"cooldown"`
+
+	AutoAssignRoles    *bool   `json:"auto_assign_roles"`
+	RoleAssignmentMode *string `json:"role_assignment_mode"`
+
+	AutoCreateProfiles *bool `json:"auto_create_profiles"`
 }
 
 type UpdateMessageEmbedSettingsOpts struct {
@@ -53,7 +156,8 @@ type UpdateMessageEmbedSettingsOpts struct {
 }
 
 type UpdateAcitivtySettings struct {
-	ChatActivity *UpdateActivitySettingsOpts `json:"chat_activity"`
+	ChatActivity  *UpdateActivitySettingsOpts `json:"chat_activity"`
+	VoiceActivity *UpdateActivitySettingsOpts `json:"voice_activity"`
 }
 
 type VoiceRoomRegister struct {
@@ -110,18 +214,207 @@ type MemberProfile struct {
 	Username    string `json:"username"`
 	AvatarURL   string `json:"avatar_url"`
 
-	CardStyle    int32          `json:"card_style"`
-	ChatActivity MemberActivity `json:"chat_activity"`
+	CardStyle     int32          `json:"card_style"`
+	ChatActivity  MemberActivity `json:"chat_activity"`
+	VoiceActivity MemberActivity `json:"voice_activity"`
+
+	// Only set when the guild automatically assigns activity roles after a grant.
+	RoleChanges *MemberRoleChanges `json:"role_changes,omitempty"`
+}
+
+// ActivityGrant is the result of granting activity points to a member.
+type ActivityGrant struct {
+	// The member's updated profile, this is nil when the grant was buffered.
+	*MemberProfile
+
+	// Whether the member's profile was created by the grant.
+	ProfileCreated bool `json:"profile_created"`
+}
+
+type ActivityAdjustmentMember struct {
+	MemberID string `json:"member_id"`
+	Points   int32  `json:"points"`
+}
+
+// The operation is one of "add", "subtract" or "set".
+// Subtracting never takes the member below 0 points.
+type AdjustActivityPointsOpts struct {
+	ActivityType string                     `json:"activity_type"`
+	Operation    string                     `json:"operation"`
+	Members      []ActivityAdjustmentMember `json:"members"`
+
+	// When enabled, the change to the member's points is also applied to the current weekly and monthly leaderboards.
+	AdjustLeaderboards bool `json:"adjust_leaderboards"`
+
+	Reason string `json:"reason"`
+}
+
+type ActivityAdjustment struct {
+	AdjustmentID         int32  `json:"adjustment_id"`
+	MemberID             string `json:"member_id"`
+	ActivityType         string `json:"activity_type"`
+	Operation            string `json:"operation"`
+	Amount               int32  `json:"amount"`
+	PreviousPoints       int32  `json:"previous_points"`
+	Points               int32  `json:"points"`
+	AdjustedLeaderboards bool   `json:"adjusted_leaderboards"`
+	Reason               string `json:"reason"`
+	ActorID              string `json:"actor_id"`
+	CreatedAt            int64  `json:"created_at"`
+}
+
+// The source is one of "grant", "adjustment", "migration", "reset" or "compacted".
+//
+// The reference is the other member for migrations and resets, and the adjustment's ID for adjustments.
+type ActivityLedgerEntry struct {
+	EntryID      int64  `json:"entry_id"`
+	ActivityType string `json:"activity_type"`
+	Source       string `json:"source"`
+	Amount       int32  `json:"amount"`
+	ChannelID    string `json:"channel_id,omitempty"`
+	Reference    string `json:"reference,omitempty"`
+	CreatedAt    int64  `json:"created_at"`
+}
+
+// Entries are ordered from newest to oldest.
+type MemberActivityLedger struct {
+	CurrentPage int32 `json:"current_page"`
+	TotalPages  int32 `json:"total_pages"`
+	HasNextPage bool  `json:"has_next_page"`
+
+	Entries []ActivityLedgerEntry `json:"entries"`
+}
+
+type MemberRoleChanges struct {
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+}
+
+type MemberActivityPeriod struct {
+	PeriodStart  int32 `json:"period_start"`
+	EarnedPoints int32 `json:"earned_points"`
+	// The member's rank for the period, this is 0 when they did not earn any points.
+	Rank      int32 `json:"rank"`
+	IsCurrent bool  `json:"is_current"`
+}
+
+type MemberActivityHistory struct {
+	ActivityType string `json:"activity_type"`
+
+	// The periods are ordered from oldest to newest, ending with the current period.
+	Weekly  []MemberActivityPeriod `json:"weekly"`
+	Monthly []MemberActivityPeriod `json:"monthly"`
+
+	// The amount of consecutive weeks the member has earned points in.
+	// The current week only counts once the member has earned points in it.
+	WeeklyStreak int32 `json:"weekly_streak"`
+	// Compares the last two completed weeks, either "up", "down" or "flat".
+	WeeklyTrend string `json:"weekly_trend"`
 }
 
 type MigrateMemberProfile struct {
 	ToMemberId string `json:"to_member_id"`
 }
 
+type VoiceSessionState struct {
+	ChannelID  string `json:"channel_id"`
+	IsMuted    bool   `json:"is_muted"`
+	IsDeafened bool   `json:"is_deafened"`
+	IsAlone    bool   `json:"is_alone"`
+}
+
+type VoiceSession struct {
+	VoiceSessionState
+
+	StartedAt     int64 `json:"started_at"`
+	LastUpdate    int64 `json:"last_update"`
+	ActiveSeconds int32 `json:"active_seconds"`
+}
+
+type ClosedVoiceSession struct {
+	ChannelID     string `json:"channel_id"`
+	StartedAt     int64  `json:"started_at"`
+	ActiveSeconds int32  `json:"active_seconds"`
+	PointsGranted int32  `json:"points_granted"`
+}
+
 type GuildLeaderboard struct {
 	CurrentPage int32 `json:"current_page"`
 	TotalPages  int32 `json:"total_pages"`
 	HasNextPage bool  `json:"has_next_page"`
 
+	// The start of the archived period being shown, this is omitted for the current period.
+	PeriodStart int32 `json:"period_start,omitempty"`
+
 	HTML string `json:"html"`
 }
+
+// RenderedCard is a card rendered to HTML or an image.
+type RenderedCard struct {
+	ContentType string `json:"content_type"`
+	Data        []byte `json:"data"`
+}
+
+type ArchivedLeaderboardPeriod struct {
+	TimePeriod   string `json:"time_period"`
+	PeriodStart  int32  `json:"period_start"`
+	TotalMembers int32  `json:"total_members"`
+}
+
+const (
+	// Allows reading guild settings, member profiles and voice rooms.
+	ScopeReadOnly = "read-only"
+	// Allows modifying guild settings and voice rooms.
+	ScopeSettingsWrite = "settings-write"
+	// Allows granting activity points and creating member profiles.
+	ScopeActivityGrant = "activity-grant"
+	// Allows generating the HTML cards.
+	ScopeHTMLOnly = "html-only"
+	// Allows managing API keys. The master key always has this scope.
+	ScopeAdmin = "admin"
+)
+
+var APIKeyScopes = []string{
+	ScopeReadOnly,
+	ScopeSettingsWrite,
+	ScopeActivityGrant,
+	ScopeHTMLOnly,
+	ScopeAdmin,
+}
+
+type APIKey struct {
+	KeyID     string   `json:"key_id"`
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes"`
+	CreatedAt int64    `json:"created_at"`
+	ExpiresAt *int64   `json:"expires_at"`
+}
+
+// HasScope checks if the key has any of the provided scopes.
+func (k APIKey) HasScope(scopes ...string) bool {
+	for _, scope := range scopes {
+		if slices.Contains(k.Scopes, scope) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type CreatedAPIKey struct {
+	APIKey
+
+	// The plaintext key. This is only ever returned once, when the key is created.
+	Key string `json:"key"`
+}
+
+type CreateAPIKeyOpts struct {
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes"`
+	ExpiresAt *int32   `json:"expires_at"`
+}
+
+type UpdateAPIKeyOpts struct {
+	Name      *string `json:"name"`
+	ExpiresAt *int32  `json:"expires_at"`
+}
@@ -0,0 +1,305 @@
+package db_cache
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/singleflight"
+)
+
+// How long a value can take to load when the cache options don't set it.
+const defaultLoadTimeout = 10 * time.Second
+
+// How long a key's generation is kept after it was last invalidated.
+// This only has to outlast any load that started before the invalidation.
+const generationTTL = 24 * time.Hour
+
+// generationKey is bumped every time the key is invalidated, so loads that started before then don't write their outdated value.
+func generationKey(key string) string {
+	return "generation:" + key
+}
+
+// setIfGeneration only writes the value when the key's generation is still the one read before the value was fetched.
+var setIfGeneration = redis.NewScript(`
+if (redis.call("GET", KEYS[2]) or "0") == ARGV[1] then
+	return redis.call("SET", KEYS[1], ARGV[2], "PX", ARGV[3])
+end
+return false
+`)
+
+type Cache struct {
+	redis       *redis.Client
+	ttl         time.Duration
+	loadTimeout time.Duration
+
+	sf singleflight.Group
+
+	// The hit and miss counters, keyed by the first segment of the cache key.
+	stats sync.Map
+}
+
+type CacheOptions struct {
+	// The redis client instance to use for caching.
+	RedisClient *redis.Client
+
+	// How long entries are cached for when they are not invalidated.
+	TTL time.Duration
+
+	// How long a value can take to be read and fetched, defaults to 10 seconds.
+	// The load is shared by every caller of the same key, so it isn't stopped when one of them is canceled.
+	LoadTimeout time.Duration
+}
+
+type counters struct {
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+var (
+	hitsDesc   = prometheus.NewDesc("database_cache_hits_total", "The amount of values read from the database cache, by kind.", []string{"kind"}, nil)
+	missesDesc = prometheus.NewDesc("database_cache_misses_total", "The amount of values that had to be fetched because they weren't cached, by kind.", []string{"kind"}, nil)
+)
+
+type Stats struct {
+	Hits   uint64 `json:"hits"`
+	Misses uint64 `json:"misses"`
+}
+
+func NewCache(opts *CacheOptions) *Cache {
+	loadTimeout := opts.LoadTimeout
+	if loadTimeout <= 0 {
+		loadTimeout = defaultLoadTimeout
+	}
+
+	return &Cache{
+		redis:       opts.RedisClient,
+		ttl:         opts.TTL,
+		loadTimeout: loadTimeout,
+	}
+}
+
+// kind gets the first segment of the key, which is what the hits and misses are grouped by.
+func kind(key string) string {
+	k, _, _ := strings.Cut(key, ":")
+	return k
+}
+
+func (c *Cache) counters(key string) *counters {
+	value, _ := c.stats.LoadOrStore(kind(key), &counters{})
+	return value.(*counters)
+}
+
+// Stats gets the amount of hits and misses for each kind of cached data.
+func (c *Cache) Stats() map[string]Stats {
+	stats := make(map[string]Stats)
+	if c == nil {
+		return stats
+	}
+
+	c.stats.Range(func(key, value any) bool {
+		counter := value.(*counters)
+		stats[key.(string)] = Stats{
+			Hits:   counter.hits.Load(),
+			Misses: counter.misses.Load(),
+		}
+
+		return true
+	})
+
+	return stats
+}
+
+// Describe implements prometheus.Collector, so the cache's stats can be exposed as metrics.
+func (c *Cache) Describe(ch chan<- *prometheus.Desc) {
+	ch <- hitsDesc
+	ch <- missesDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *Cache) Collect(ch chan<- prometheus.Metric) {
+	for kind, stats := range c.Stats() {
+		ch <- prometheus.MustNewConstMetric(hitsDesc, prometheus.CounterValue, float64(stats.Hits), kind)
+		ch <- prometheus.MustNewConstMetric(missesDesc, prometheus.CounterValue, float64(stats.Misses), kind)
+	}
+}
+
+// Get reads the value from the cache, falling back to fetch when it isn't cached.
+// The cache is best-effort, when redis is unavailable the value is always fetched.
+//
+// A nil cache always fetches.
+func Get[T any](ctx context.Context, c *Cache, key string, fetch func(ctx context.Context) (T, error)) (T, error) {
+	return GetWithTTL(ctx, c, key, 0, fetch)
+}
+
+// GetWithTTL is the same as Get, but caches the value for the given TTL instead of the cache's default.
+func GetWithTTL[T any](ctx context.Context, c *Cache, key string, ttl time.Duration, fetch func(ctx context.Context) (T, error)) (T, error) {
+	if c == nil {
+		return fetch(ctx)
+	}
+
+	if ttl <= 0 {
+		ttl = c.ttl
+	}
+
+	// The load is shared with every other caller of the key, so it can't be canceled by the caller that happened to start it.
+	// Each caller still stops waiting when their own context is done.
+	loaded := c.sf.DoChan(key, func() (any, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.loadTimeout)
+		defer cancel()
+
+		var value T
+
+		// The generation is read along with the value, so an invalidation while the value is being fetched can be detected.
+		generation := "0"
+		values, err := c.redis.MGet(ctx, key, generationKey(key)).Result()
+		if err == nil {
+			if cached, ok := values[0].(string); ok {
+				if err := json.Unmarshal([]byte(cached), &value); err == nil {
+					c.counters(key).hits.Add(1)
+					return value, nil
+				}
+			}
+
+			if current, ok := values[1].(string); ok {
+				generation = current
+			}
+		} else {
+			log.WithError(err).WithField("key", key).Warn("Failed to read from the database cache.")
+		}
+
+		c.counters(key).misses.Add(1)
+
+		value, err = fetch(ctx)
+		if err != nil {
+			return value, err
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return value, nil
+		}
+
+		err = setIfGeneration.Run(ctx, c.redis, []string{key, generationKey(key)}, generation, encoded, ttl.Milliseconds()).Err()
+		if err != nil && err != redis.Nil {
+			log.WithError(err).WithField("key", key).Warn("Failed to write to the database cache.")
+		}
+
+		return value, nil
+	})
+
+	var result singleflight.Result
+	select {
+	case result = <-loaded:
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+
+	if result.Err != nil {
+		var zero T
+		return zero, result.Err
+	}
+
+	return result.Val.(T), nil
+}
+
+// Invalidate removes the keys from the cache.
+// Values that were being loaded when the keys were invalidated aren't cached, since they could be outdated.
+func (c *Cache) Invalidate(ctx context.Context, keys ...string) {
+	if c == nil || len(keys) == 0 {
+		return
+	}
+
+	pipeline := c.redis.TxPipeline()
+	pipeline.Del(ctx, keys...)
+	for _, key := range keys {
+		pipeline.Incr(ctx, generationKey(key))
+		pipeline.Expire(ctx, generationKey(key), generationTTL)
+
+		// Callers after this shouldn't get the value of a load that started before it.
+		c.sf.Forget(key)
+	}
+
+	if _, err := pipeline.Exec(ctx); err != nil {
+		log.WithError(err).WithField("keys", keys).Warn("Failed to invalidate the database cache.")
+	}
+}
+
+// InvalidatePattern removes every key matching the pattern from the cache.
+func (c *Cache) InvalidatePattern(ctx context.Context, pattern string) {
+	if c == nil {
+		return
+	}
+
+	iter := c.redis.Scan(ctx, 0, pattern, 100).Iterator()
+
+	keys := make([]string, 0)
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+
+	if err := iter.Err(); err != nil {
+		log.WithError(err).WithField("pattern", pattern).Warn("Failed to scan the database cache.")
+		return
+	}
+
+	c.Invalidate(ctx, keys...)
+}
+
+// SetIfAbsent sets the key for the TTL, only when it doesn't already exist.
+// This returns false when the key already exists, which can be used for things like cooldowns.
+func (c *Cache) SetIfAbsent(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	return c.redis.SetNX(ctx, key, 1, ttl).Result()
+}
+
+// TTL gets how long is left until the key expires.
+// This is 0 when the key doesn't exist or doesn't expire.
+func (c *Cache) TTL(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := c.redis.TTL(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	return max(0, ttl), nil
+}
+
+// Version gets the key's version, which is 0 until it's bumped for the first time.
+// This returns false when the version can't be read, in which case nothing should be cached by it.
+//
+// Versions never expire, otherwise a version could be reused for different data once it's bumped again.
+func (c *Cache) Version(ctx context.Context, key string) (int64, bool) {
+	if c == nil {
+		return 0, false
+	}
+
+	version, err := c.redis.Get(ctx, key).Int64()
+	if err != nil && err != redis.Nil {
+		log.WithError(err).WithField("key", key).Warn("Failed to read the version from the database cache.")
+		return 0, false
+	}
+
+	return version, true
+}
+
+// BumpVersion increments the version of every key, so anything cached by their old versions is no longer used.
+func (c *Cache) BumpVersion(ctx context.Context, keys ...string) {
+	if c == nil || len(keys) == 0 {
+		return
+	}
+
+	pipeline := c.redis.Pipeline()
+	for _, key := range keys {
+		pipeline.Incr(ctx, key)
+	}
+
+	if _, err := pipeline.Exec(ctx); err != nil {
+		log.WithError(err).WithField("keys", keys).Warn("Failed to bump the version in the database cache.")
+	}
+}
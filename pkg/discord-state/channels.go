@@ -0,0 +1,49 @@
+package discord_state
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/redis/go-redis/v9"
+	"github.com/typical-developers/discord-bot-backend/pkg/redisx"
+)
+
+func (s *StateManager) Channel(ctx context.Context, channelId string) (*discordgo.Channel, error) {
+	key := fmt.Sprintf("channel:%s", channelId)
+
+	result, err, _ := s.sf.Do(fmt.Sprintf("channel:%s", channelId), func() (any, error) {
+		var channel *discordgo.Channel
+		err := redisx.JSONUnwrap(ctx, s.redis, key, "$", &channel)
+
+		if err != nil {
+			if err != redis.Nil {
+				return nil, err
+			}
+			cacheMisses.WithLabelValues("channel").Inc()
+
+			channel, err = s.Session.Channel(channelId, discordgo.WithContext(ctx), discordgo.WithRetryOnRatelimit(true))
+			restFallback(ctx, "channel", err)
+			if err != nil {
+				return nil, err
+			}
+
+			pipeline := s.redis.Pipeline()
+			pipeline.JSONSet(ctx, key, "$", channel)
+			pipeline.Expire(ctx, key, time.Hour)
+			_, _ = pipeline.Exec(ctx)
+
+			return channel, nil
+		}
+
+		cacheHits.WithLabelValues("channel").Inc()
+		return channel, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*discordgo.Channel), nil
+}
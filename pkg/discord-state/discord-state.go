@@ -6,11 +6,14 @@ import (
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
 	"golang.org/x/sync/singleflight"
 )
 
 const ()
 
+var tracer = otel.Tracer("github.com/typical-developers/discord-bot-backend/pkg/discord-state")
+
 type StateManager struct {
 	Session *discordgo.Session
 	redis   *redis.Client
@@ -54,6 +57,10 @@ func NewStateManager(opts *StateManagerOptions) *StateManager {
 			state.redis.JSONSet(ctx, fmt.Sprintf("guild:%s:role:%s", e.GuildID, e.Role.ID), "$", e.Role)
 		case *discordgo.GuildRoleDelete:
 			state.redis.JSONDel(ctx, fmt.Sprintf("guild:%s:role:%s", e.GuildID, e.RoleID), "$")
+		case *discordgo.ChannelUpdate:
+			state.redis.JSONSet(ctx, fmt.Sprintf("channel:%s", e.ID), "$", e.Channel)
+		case *discordgo.ChannelDelete:
+			state.redis.JSONDel(ctx, fmt.Sprintf("channel:%s", e.ID), "$")
 		}
 	})
 
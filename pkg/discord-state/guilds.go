@@ -20,8 +20,10 @@ func (s *StateManager) Guild(ctx context.Context, guildId string) (*discordgo.Gu
 			if err != redis.Nil {
 				return nil, err
 			}
+			cacheMisses.WithLabelValues("guild").Inc()
 
 			guild, err = s.Session.Guild(guildId, discordgo.WithContext(ctx), discordgo.WithRetryOnRatelimit(true))
+			restFallback(ctx, "guild", err)
 			if err != nil {
 				return nil, err
 			}
@@ -33,6 +35,7 @@ func (s *StateManager) Guild(ctx context.Context, guildId string) (*discordgo.Gu
 			return guild, nil
 		}
 
+		cacheHits.WithLabelValues("guild").Inc()
 		return guild, nil
 	})
 
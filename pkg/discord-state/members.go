@@ -11,6 +11,8 @@ import (
 	"github.com/bwmarrin/discordgo"
 	"github.com/redis/go-redis/v9"
 	"github.com/typical-developers/discord-bot-backend/pkg/redisx"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func (s *StateManager) GuildMember(ctx context.Context, guildId, userId string) (*discordgo.Member, error) {
@@ -24,8 +26,10 @@ func (s *StateManager) GuildMember(ctx context.Context, guildId, userId string)
 			if err != redis.Nil {
 				return nil, err
 			}
+			cacheMisses.WithLabelValues("member").Inc()
 
 			member, err := s.Session.GuildMember(guildId, userId, discordgo.WithContext(ctx), discordgo.WithRetryOnRatelimit(true))
+			restFallback(ctx, "member", err)
 			if err != nil {
 				var dgError *discordgo.RESTError
 				if errors.As(err, &dgError) && dgError.Message.Code == discordgo.ErrCodeUnknownMember {
@@ -47,6 +51,7 @@ func (s *StateManager) GuildMember(ctx context.Context, guildId, userId string)
 			return member, nil
 		}
 
+		cacheHits.WithLabelValues("member").Inc()
 		return member, nil
 	})
 
@@ -87,7 +92,13 @@ func (s *StateManager) RequestGuildMembersList(ctx context.Context, guildId stri
 		}
 
 		if len(userIds) > 0 {
+			_, span := tracer.Start(ctx, "discord_state.RequestGuildMembersList", trace.WithAttributes(
+				attribute.String("guild_id", guildId),
+				attribute.Int("requested_members", len(userIds)),
+			))
 			err := s.Session.RequestGuildMembersList(guildId, userIds, limit, nonce, presences)
+			span.End()
+
 			if err != nil {
 				return nil, err
 			}
@@ -0,0 +1,40 @@
+package discord_state
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	cacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "discord_state_cache_hits_total",
+		Help: "The amount of Discord resources read from the cache, by resource.",
+	}, []string{"resource"})
+
+	cacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "discord_state_cache_misses_total",
+		Help: "The amount of Discord resources that weren't cached, by resource.",
+	}, []string{"resource"})
+
+	restFallbacks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "discord_state_rest_fallbacks_total",
+		Help: "The amount of requests made to the Discord REST API because a resource wasn't cached, by resource and whether it failed.",
+	}, []string{"resource", "result"})
+)
+
+// restFallback records a request made to the Discord REST API because the resource wasn't cached.
+func restFallback(ctx context.Context, resource string, err error) {
+	logger := log.WithContext(ctx).WithField("resource", resource)
+
+	if err != nil {
+		restFallbacks.WithLabelValues(resource, "error").Inc()
+		logger.WithError(err).Debug("Failed to fetch uncached Discord resource.")
+		return
+	}
+
+	restFallbacks.WithLabelValues(resource, "ok").Inc()
+	logger.Debug("Fetched uncached Discord resource.")
+}
@@ -21,8 +21,10 @@ func (s *StateManager) GuildRoles(ctx context.Context, guildId string) ([]*disco
 			if err != redis.Nil {
 				return nil, err
 			}
+			cacheMisses.WithLabelValues("role").Inc()
 
 			roles, err := s.Session.GuildRoles(guildId, discordgo.WithContext(ctx), discordgo.WithRetryOnRatelimit(true))
+			restFallback(ctx, "role", err)
 			if err != nil {
 				return nil, err
 			}
@@ -39,6 +41,7 @@ func (s *StateManager) GuildRoles(ctx context.Context, guildId string) ([]*disco
 			return roles, nil
 		}
 
+		cacheHits.WithLabelValues("role").Inc()
 		return roles, nil
 	})
 
@@ -60,6 +63,7 @@ func (s *StateManager) GuildRole(ctx context.Context, guildId, roleId string) (*
 			if err != redis.Nil {
 				return nil, err
 			}
+			cacheMisses.WithLabelValues("role").Inc()
 
 			roles, err := s.GuildRoles(ctx, guildId)
 			if err != nil {
@@ -75,6 +79,7 @@ func (s *StateManager) GuildRole(ctx context.Context, guildId, roleId string) (*
 			return nil, ErrRoleNotFound
 		}
 
+		cacheHits.WithLabelValues("role").Inc()
 		return role, nil
 	})
 
@@ -21,8 +21,10 @@ func (s *StateManager) User(ctx context.Context, userId string) (*discordgo.User
 			if err != redis.Nil {
 				return nil, err
 			}
+			cacheMisses.WithLabelValues("user").Inc()
 
 			user, err := s.Session.User(userId, discordgo.WithContext(ctx), discordgo.WithRetryOnRatelimit(true))
+			restFallback(ctx, "user", err)
 			if err != nil {
 				return nil, err
 			}
@@ -35,6 +37,7 @@ func (s *StateManager) User(ctx context.Context, userId string) (*discordgo.User
 			return user, nil
 		}
 
+		cacheHits.WithLabelValues("user").Inc()
 		return user, nil
 	})
 
@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// The bucket is refilled and taken from in a single script, so concurrent requests can't overdraw it.
+// Redis' clock is used so every instance of the service agrees on the time.
+var tokenBucket = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+
+local time = redis.call("TIME")
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "updated_at")
+local tokens = tonumber(bucket[1]) or burst
+local updated_at = tonumber(bucket[2]) or now
+
+tokens = math.min(burst, tokens + math.max(0, now - updated_at) * rate / 1000)
+
+local allowed = 0
+local retry_after = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry_after = math.ceil((1 - tokens) * 1000 / rate)
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "updated_at", now)
+redis.call("PEXPIRE", KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+
+return {allowed, retry_after}
+`)
+
+type Limiter struct {
+	redis *redis.Client
+}
+
+type LimiterOptions struct {
+	// The redis client instance to store the buckets in.
+	RedisClient *redis.Client
+}
+
+// Limit is a token bucket that holds up to Burst tokens and is refilled at Rate tokens per second.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+func NewLimiter(opts *LimiterOptions) *Limiter {
+	return &Limiter{
+		redis: opts.RedisClient,
+	}
+}
+
+// Allow takes a token from the key's bucket.
+// When the bucket is empty, this returns false with how long until the next token is available.
+func (l *Limiter) Allow(ctx context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	result, err := tokenBucket.Run(ctx, l.redis, []string{key}, limit.Rate, limit.Burst).Int64Slice()
+	if err != nil {
+		return false, 0, err
+	}
+
+	return result[0] == 1, time.Duration(result[1]) * time.Millisecond, nil
+}
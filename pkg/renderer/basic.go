@@ -0,0 +1,122 @@
+package renderer
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	basicCardWidth   = 700
+	basicCardPadding = 24
+	basicTitleSize   = 28
+	basicLineSize    = 20
+)
+
+var (
+	basicBackground = color.RGBA{R: 0x12, G: 0x10, B: 0x1A, A: 0xFF}
+	basicTextColor  = color.RGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF}
+)
+
+// BasicRenderer draws the plain text version of cards with the Fixel font, without a browser.
+// It's meant for tests and environments where Chromium isn't available, so it only supports PNG.
+type BasicRenderer struct {
+	font *opentype.Font
+}
+
+type BasicOptions struct {
+	// The directory containing fonts/Fixel/FixelVariable.ttf, i.e. "./assets".
+	AssetsDir string
+}
+
+func NewBasicRenderer(opts *BasicOptions) (*BasicRenderer, error) {
+	data, err := os.ReadFile(filepath.Join(opts.AssetsDir, "fonts", "Fixel", "FixelVariable.ttf"))
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := opentype.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BasicRenderer{font: f}, nil
+}
+
+// face creates a font face for the size.
+// Faces aren't safe to share between goroutines, so they're created for each render.
+func (r *BasicRenderer) face(size float64) (font.Face, error) {
+	return opentype.NewFace(r.font, &opentype.FaceOptions{
+		Size:    size,
+		DPI:     72,
+		Hinting: font.HintingFull,
+	})
+}
+
+func (r *BasicRenderer) Render(ctx context.Context, p Page, format Format) ([]byte, error) {
+	if format != FormatPNG {
+		return nil, ErrUnsupportedFormat
+	}
+
+	titleFace, err := r.face(basicTitleSize)
+	if err != nil {
+		return nil, err
+	}
+	defer titleFace.Close()
+
+	lineFace, err := r.face(basicLineSize)
+	if err != nil {
+		return nil, err
+	}
+	defer lineFace.Close()
+
+	titleHeight := titleFace.Metrics().Height.Ceil()
+	lineHeight := lineFace.Metrics().Height.Ceil()
+	height := basicCardPadding*2 + titleHeight + lineHeight*len(p.Lines)
+
+	img := image.NewRGBA(image.Rect(0, 0, basicCardWidth, height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(basicBackground), image.Point{}, draw.Src)
+
+	drawer := &font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(basicTextColor),
+		Face: titleFace,
+	}
+
+	y := basicCardPadding + titleFace.Metrics().Ascent.Ceil()
+	drawer.Dot = fixed.P(basicCardPadding, y)
+	drawer.DrawString(p.Title)
+
+	drawer.Face = lineFace
+	y += titleFace.Metrics().Descent.Ceil() + lineFace.Metrics().Ascent.Ceil()
+	for _, line := range p.Lines {
+		drawer.Dot = fixed.P(basicCardPadding, y)
+		drawer.DrawString(line)
+
+		y += lineHeight
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (r *BasicRenderer) Close() error {
+	return nil
+}
@@ -0,0 +1,112 @@
+package renderer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image/png"
+	"testing"
+)
+
+func newTestBasicRenderer(t *testing.T) *BasicRenderer {
+	t.Helper()
+
+	r, err := NewBasicRenderer(&BasicOptions{AssetsDir: "../../assets"})
+	if err != nil {
+		t.Fatalf("failed to create the renderer: %v", err)
+	}
+	t.Cleanup(func() { _ = r.Close() })
+
+	return r
+}
+
+func renderBasic(t *testing.T, r *BasicRenderer, page Page) ([]byte, int, int) {
+	t.Helper()
+
+	data, err := r.Render(context.Background(), page, FormatPNG)
+	if err != nil {
+		t.Fatalf("failed to render the page: %v", err)
+	}
+
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("the rendered card isn't a PNG: %v", err)
+	}
+
+	bounds := img.Bounds()
+	return data, bounds.Dx(), bounds.Dy()
+}
+
+func TestBasicRendererRendersPNG(t *testing.T) {
+	r := newTestBasicRenderer(t)
+
+	_, width, height := renderBasic(t, r, Page{
+		Title: "Member",
+		Lines: []string{"@member", "Chat: 120 points, #3 all time"},
+	})
+
+	if width != basicCardWidth {
+		t.Errorf("expected the card to be %d pixels wide, got %d", basicCardWidth, width)
+	}
+
+	if height <= basicCardPadding*2 {
+		t.Errorf("expected the card to be taller than its padding, got %d", height)
+	}
+}
+
+func TestBasicRendererGrowsWithLines(t *testing.T) {
+	r := newTestBasicRenderer(t)
+
+	_, _, short := renderBasic(t, r, Page{Title: "Leaderboard", Lines: []string{"#1 first"}})
+	_, _, tall := renderBasic(t, r, Page{Title: "Leaderboard", Lines: []string{"#1 first", "#2 second", "#3 third"}})
+
+	if tall <= short {
+		t.Errorf("expected more lines to make the card taller, got %d for 1 line and %d for 3 lines", short, tall)
+	}
+}
+
+func TestBasicRendererIsDeterministic(t *testing.T) {
+	r := newTestBasicRenderer(t)
+	page := Page{Title: "Member", Lines: []string{"Voice: 42 points, #1 all time"}}
+
+	first, _, _ := renderBasic(t, r, page)
+	second, _, _ := renderBasic(t, r, page)
+
+	if !bytes.Equal(first, second) {
+		t.Error("expected the same page to render the same image")
+	}
+}
+
+func TestBasicRendererOnlySupportsPNG(t *testing.T) {
+	r := newTestBasicRenderer(t)
+
+	_, err := r.Render(context.Background(), Page{Title: "Member"}, FormatWebP)
+	if !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("expected ErrUnsupportedFormat, got %v", err)
+	}
+}
+
+func TestBasicRendererCanceled(t *testing.T) {
+	r := newTestBasicRenderer(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := r.Render(ctx, Page{Title: "Member"}, FormatPNG)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for name, expected := range map[string]Format{"png": FormatPNG, "webp": FormatWebP} {
+		format, err := ParseFormat(name)
+		if err != nil || format != expected {
+			t.Errorf("ParseFormat(%q) = %q, %v; expected %q", name, format, err, expected)
+		}
+	}
+
+	if _, err := ParseFormat("gif"); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("expected ErrUnknownFormat for an unknown format, got %v", err)
+	}
+}
@@ -0,0 +1,217 @@
+package renderer
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/chromedp/cdproto/page"
+	"github.com/chromedp/cdproto/runtime"
+	"github.com/chromedp/chromedp"
+)
+
+var errSelectorNotFound = errors.New("the selector didn't match an element on the page")
+
+// The script that waits for the card's fonts, then measures the element being captured.
+const measureScript = `document.fonts.ready.then(() => {
+	const element = %s ? document.querySelector(%[1]s) : document.documentElement;
+	if (!element) {
+		return null;
+	}
+
+	const rect = element.getBoundingClientRect();
+	return { x: rect.x + window.scrollX, y: rect.y + window.scrollY, width: rect.width, height: rect.height };
+})`
+
+type rect struct {
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+}
+
+// ChromiumRenderer screenshots cards with a local headless Chromium.
+//
+// Pages are served to the browser from a loopback server, alongside the assets directory under /static/,
+// so the cards' stylesheets and fonts load the same way they do when they're served by the API.
+type ChromiumRenderer struct {
+	server   *http.Server
+	baseURL  string
+	pages    sync.Map
+	tabs     chan struct{}
+	browser  context.Context
+	shutdown context.CancelFunc
+}
+
+type ChromiumOptions struct {
+	// The path to the Chromium (or Chrome) executable.
+	// When this is empty, the usual install locations and the PATH are searched.
+	ExecPath string
+
+	// The directory that's served under /static/, i.e. "./assets".
+	AssetsDir string
+
+	// The amount of cards that can be rendered at once, each one uses its own tab.
+	// Defaults to 4.
+	MaxTabs int
+}
+
+func NewChromiumRenderer(opts *ChromiumOptions) (*ChromiumRenderer, error) {
+	maxTabs := opts.MaxTabs
+	if maxTabs <= 0 {
+		maxTabs = 4
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ChromiumRenderer{
+		baseURL: fmt.Sprintf("http://%s", listener.Addr().String()),
+		tabs:    make(chan struct{}, maxTabs),
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/static/", http.StripPrefix("/static/", http.FileServer(http.Dir(opts.AssetsDir))))
+	mux.HandleFunc("/pages/", r.servePage)
+
+	r.server = &http.Server{Handler: mux}
+	go func() {
+		_ = r.server.Serve(listener)
+	}()
+
+	allocOpts := append(chromedp.DefaultExecAllocatorOptions[:],
+		chromedp.WindowSize(1280, 1024),
+	)
+	if opts.ExecPath != "" {
+		allocOpts = append(allocOpts, chromedp.ExecPath(opts.ExecPath))
+	}
+	// Chromium refuses to start its sandbox as root, which is usually the case in containers.
+	if os.Geteuid() == 0 {
+		allocOpts = append(allocOpts, chromedp.NoSandbox)
+	}
+
+	allocCtx, cancelAlloc := chromedp.NewExecAllocator(context.Background(), allocOpts...)
+	browserCtx, cancelBrowser := chromedp.NewContext(allocCtx)
+
+	r.browser = browserCtx
+	r.shutdown = func() {
+		cancelBrowser()
+		cancelAlloc()
+	}
+
+	// Running without any actions starts the browser, so a missing executable is caught on startup.
+	if err := chromedp.Run(browserCtx); err != nil {
+		r.shutdown()
+		_ = r.server.Close()
+
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// servePage serves the HTML of a page that's currently being rendered.
+func (r *ChromiumRenderer) servePage(w http.ResponseWriter, req *http.Request) {
+	id := strings.TrimPrefix(req.URL.Path, "/pages/")
+
+	html, ok := r.pages.Load(id)
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write(html.([]byte))
+}
+
+func (r *ChromiumRenderer) Render(ctx context.Context, p Page, format Format) ([]byte, error) {
+	var screenshotFormat page.CaptureScreenshotFormat
+	switch format {
+	case FormatPNG:
+		screenshotFormat = page.CaptureScreenshotFormatPng
+	case FormatWebP:
+		screenshotFormat = page.CaptureScreenshotFormatWebp
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+
+	select {
+	case r.tabs <- struct{}{}:
+		defer func() { <-r.tabs }()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	idBytes := make([]byte, 16)
+	if _, err := rand.Read(idBytes); err != nil {
+		return nil, err
+	}
+	id := hex.EncodeToString(idBytes)
+
+	r.pages.Store(id, p.HTML)
+	defer r.pages.Delete(id)
+
+	// Tabs have to be created from the browser's context, so the request's context is tied to it instead.
+	tabCtx, cancel := chromedp.NewContext(r.browser)
+	defer cancel()
+	stop := context.AfterFunc(ctx, cancel)
+	defer stop()
+
+	selector := "null"
+	if p.Selector != "" {
+		selector = fmt.Sprintf("%q", p.Selector)
+	}
+
+	var bounds *rect
+	var image []byte
+	err := chromedp.Run(tabCtx,
+		chromedp.Navigate(fmt.Sprintf("%s/pages/%s", r.baseURL, id)),
+		chromedp.Evaluate(fmt.Sprintf(measureScript, selector), &bounds, func(p *runtime.EvaluateParams) *runtime.EvaluateParams {
+			return p.WithAwaitPromise(true)
+		}),
+		chromedp.ActionFunc(func(ctx context.Context) error {
+			if bounds == nil {
+				return errSelectorNotFound
+			}
+
+			var err error
+			image, err = page.CaptureScreenshot().
+				WithFormat(screenshotFormat).
+				WithCaptureBeyondViewport(true).
+				WithClip(&page.Viewport{
+					X:      bounds.X,
+					Y:      bounds.Y,
+					Width:  bounds.Width,
+					Height: bounds.Height,
+					Scale:  1,
+				}).
+				Do(ctx)
+
+			return err
+		}),
+	)
+
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		return nil, err
+	}
+
+	return image, nil
+}
+
+func (r *ChromiumRenderer) Close() error {
+	r.shutdown()
+	return r.server.Close()
+}
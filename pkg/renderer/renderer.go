@@ -0,0 +1,57 @@
+package renderer
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrUnknownFormat     = errors.New("the image format is unknown")
+	ErrUnsupportedFormat = errors.New("the renderer doesn't support the image format")
+)
+
+type Format string
+
+const (
+	FormatPNG  Format = "png"
+	FormatWebP Format = "webp"
+)
+
+// ParseFormat gets the image format from its name, i.e. the format query parameter.
+func ParseFormat(name string) (Format, error) {
+	switch Format(name) {
+	case FormatPNG:
+		return FormatPNG, nil
+	case FormatWebP:
+		return FormatWebP, nil
+	}
+
+	return "", ErrUnknownFormat
+}
+
+func (f Format) ContentType() string {
+	return "image/" + string(f)
+}
+
+// Page is a card to render.
+type Page struct {
+	// The card's HTML document.
+	// Stylesheets, fonts and images under /static/ are resolved from the renderer's assets directory.
+	HTML []byte
+
+	// The element that's captured, i.e. "#root".
+	// When this is empty, the whole page is captured.
+	Selector string
+
+	// A plain text version of the card, for renderers that can't lay out HTML.
+	Title string
+	Lines []string
+}
+
+type Renderer interface {
+	// Render draws the page as an image in the given format.
+	Render(ctx context.Context, page Page, format Format) ([]byte, error)
+
+	// Close releases anything held by the renderer, i.e. the browser process.
+	Close() error
+}
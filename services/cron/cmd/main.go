@@ -1,18 +1,31 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"reflect"
 	"runtime"
+	"strings"
 	"time"
 
+	"github.com/bwmarrin/discordgo"
 	. "github.com/luckfire-go/cron-scheduler"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/redis/go-redis/extra/redisotel/v9"
+	"github.com/redis/go-redis/v9"
 	"github.com/robfig/cron/v3"
 	log "github.com/sirupsen/logrus"
 	"github.com/typical-developers/discord-bot-backend/internal/db"
-	_ "github.com/typical-developers/discord-bot-backend/internal/logger"
+	"github.com/typical-developers/discord-bot-backend/internal/logger"
+	"github.com/typical-developers/discord-bot-backend/internal/metrics"
+	"github.com/typical-developers/discord-bot-backend/internal/tracing"
+	db_cache "github.com/typical-developers/discord-bot-backend/pkg/db-cache"
+	discord_state "github.com/typical-developers/discord-bot-backend/pkg/discord-state"
 	"github.com/typical-developers/discord-bot-backend/services/cron/config"
 	"github.com/typical-developers/discord-bot-backend/services/cron/tasks"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 func dbConnect() (*sql.DB, error) {
@@ -34,13 +47,144 @@ func dbConnect() (*sql.DB, error) {
 	return db, nil
 }
 
+func discordRedisConnect() (*redis.Client, error) {
+	client := redis.NewClient(&redis.Options{
+		Addr:     fmt.Sprintf("%s:%d", config.C.DiscordCache.Host, config.C.DiscordCache.Port),
+		Password: config.C.DiscordCache.Password,
+		DB:       config.C.DiscordCache.DB,
+	})
+
+	if config.C.Tracing.Enabled {
+		if err := redisotel.InstrumentTracing(client); err != nil {
+			return nil, err
+		}
+	}
+
+	return client, nil
+}
+
+// databaseCacheConnect connects to the web service's database cache, which is optional.
+// Without it, the data that tasks change stays cached until it expires.
+func databaseCacheConnect() (*db_cache.Cache, error) {
+	if config.C.DatabaseCache.Host == "" {
+		return nil, nil
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:     fmt.Sprintf("%s:%d", config.C.DatabaseCache.Host, config.C.DatabaseCache.Port),
+		Password: config.C.DatabaseCache.Password,
+		DB:       config.C.DatabaseCache.DB,
+	})
+
+	if config.C.Tracing.Enabled {
+		if err := redisotel.InstrumentTracing(client); err != nil {
+			return nil, err
+		}
+	}
+
+	return db_cache.NewCache(&db_cache.CacheOptions{RedisClient: client}), nil
+}
+
+// jobError carries the name of the job that failed to the registry's callback.
+type jobError struct {
+	job string
+	err error
+}
+
+func (e *jobError) Error() string {
+	return e.err.Error()
+}
+
+func (e *jobError) Unwrap() error {
+	return e.err
+}
+
+// jobName gets the name of the task's method, i.e. "FlushWeeklyActivityLeaderboard".
+func jobName(task func(ctx context.Context) error) string {
+	name := runtime.FuncForPC(reflect.ValueOf(task).Pointer()).Name()
+	name = strings.TrimSuffix(name, "-fm")
+
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+// instrument records how many times the task has run and how long it took.
+// Failures are recorded by the registry's OnJobFailed callback.
+func instrument(task func(ctx context.Context) error) func(ctx context.Context) error {
+	name := jobName(task)
+
+	return func(ctx context.Context) error {
+		ctx, span := tracing.Start(ctx, "cron."+name)
+
+		start := time.Now()
+		err := task(ctx)
+		tracing.End(span, err)
+
+		metrics.CronJobRuns.WithLabelValues(name).Inc()
+		metrics.CronJobDuration.WithLabelValues(name).Observe(time.Since(start).Seconds())
+
+		if err != nil {
+			return &jobError{job: name, err: err}
+		}
+
+		return nil
+	}
+}
+
 func main() {
+	logger.SetFormat(config.C.LogFormat)
+
 	pqdb, err := dbConnect()
 	if err != nil {
 		panic(err)
 	}
-	queries := db.New(pqdb)
-	tasks := tasks.NewTasks(pqdb, queries)
+	if config.C.Tracing.Enabled {
+		_, err := tracing.Setup(context.Background(), tracing.Options{
+			ServiceName: "discord-bot-cron",
+			Endpoint:    config.C.Tracing.Endpoint,
+			SampleRatio: config.C.Tracing.SampleRatio,
+		})
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	var queries db.TxQuerier = db.New(pqdb)
+	if config.C.Tracing.Enabled {
+		queries = tracing.NewQuerier(queries)
+	}
+
+	metrics.Register(collectors.NewDBStatsCollector(pqdb, "postgres"))
+	if config.C.MetricsPort != 0 {
+		go metrics.Serve(context.Background(), config.C.MetricsPort)
+	}
+
+	// The cron service only uses the REST API, so the gateway connection is never opened.
+	discord, err := discordgo.New("Bot " + config.C.DiscordToken)
+	if err != nil {
+		panic(err)
+	}
+	if config.C.Tracing.Enabled {
+		discord.Client.Transport = otelhttp.NewTransport(discord.Client.Transport)
+	}
+
+	discordCache, err := discordRedisConnect()
+	if err != nil {
+		panic(err)
+	}
+	discordState := discord_state.NewStateManager(&discord_state.StateManagerOptions{
+		DiscordSession: discord,
+		RedisClient:    discordCache,
+	})
+
+	dbCache, err := databaseCacheConnect()
+	if err != nil {
+		panic(err)
+	}
+
+	tasks := tasks.NewTasks(pqdb, queries, discordState, dbCache,
+		time.Duration(config.C.VoiceSessionTimeout)*time.Second,
+		time.Duration(config.C.ActivityLedgerRetention)*24*time.Hour,
+	)
 
 	registry := NewRegistry(cron.WithLocation(time.UTC))
 	registry.OnJobAddSuccess = func(job *RegistryItem) {
@@ -57,8 +201,15 @@ func main() {
 		}).Error("Failed to add job to registry.")
 	}
 	registry.OnJobFailed = func(job *RegistryItem, err error) {
+		name := "unknown"
+		var jobErr *jobError
+		if errors.As(err, &jobErr) {
+			name = jobErr.job
+		}
+		metrics.CronJobFailures.WithLabelValues(name).Inc()
+
 		log.WithFields(log.Fields{
-			"name": job.Name,
+			"name": name,
 			"spec": job.Spec,
 			"err":  err,
 		}).Error("Job failed to run.")
@@ -70,14 +221,42 @@ func main() {
 			RunOnRegister: true,
 
 			Spec:     "0 0 * * 1",
-			TaskFunc: tasks.FlushWeeklyActivityLeaderboard,
+			TaskFunc: instrument(tasks.FlushWeeklyActivityLeaderboard),
 		},
 		{
 			Enabled:       true,
 			RunOnRegister: true,
 
 			Spec:     "0 0 1 * *",
-			TaskFunc: tasks.FlushMonthlyActivityLeaderboard,
+			TaskFunc: instrument(tasks.FlushMonthlyActivityLeaderboard),
+		},
+		{
+			Enabled:       true,
+			RunOnRegister: true,
+
+			Spec:     "*/5 * * * *",
+			TaskFunc: instrument(tasks.ReconcileVoiceSessions),
+		},
+		{
+			Enabled:       true,
+			RunOnRegister: true,
+
+			Spec:     "* * * * *",
+			TaskFunc: instrument(tasks.ProcessActivityBoosts),
+		},
+		{
+			Enabled:       true,
+			RunOnRegister: true,
+
+			Spec:     "* * * * *",
+			TaskFunc: instrument(tasks.ResyncActivityRoles),
+		},
+		{
+			Enabled:       config.C.ActivityLedgerRetention > 0,
+			RunOnRegister: true,
+
+			Spec:     "0 3 * * *",
+			TaskFunc: instrument(tasks.CompactActivityLedger),
 		},
 	})
 
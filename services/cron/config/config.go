@@ -11,6 +11,32 @@ type Config struct {
 	Port int `env:"PORT" envDefault:"8080"`
 
 	LogLevel string `env:"LOG_LEVEL" envDefault:"info"`
+	// Either "json" for one JSON object per line, or "text" for a human-readable format when running locally.
+	LogFormat string `env:"LOG_FORMAT" envDefault:"json"`
+
+	// The port to expose Prometheus metrics on, at /metrics.
+	// Setting it to 0 disables the metrics.
+	MetricsPort int `env:"METRICS_PORT" envDefault:"9090"`
+
+	// Exports OpenTelemetry traces over OTLP/HTTP, this is disabled by default.
+	//
+	// When the endpoint is empty, the standard OTEL_EXPORTER_OTLP_* environment variables are used.
+	// The sample ratio is the fraction of new traces that are sampled, between 0 and 1.
+	Tracing struct {
+		Enabled     bool    `env:"ENABLED" envDefault:"false"`
+		Endpoint    string  `env:"ENDPOINT"`
+		SampleRatio float64 `env:"SAMPLE_RATIO" envDefault:"1"`
+	} `envPrefix:"TRACING_"`
+
+	// The amount of seconds a voice session can go without being updated before it's treated as orphaned.
+	VoiceSessionTimeout int `env:"VOICE_SESSION_TIMEOUT" envDefault:"900"`
+
+	// The amount of days activity ledger entries are kept before they're compacted into a single entry per member.
+	// Setting it to 0 disables compacting the ledger.
+	ActivityLedgerRetention int `env:"ACTIVITY_LEDGER_RETENTION" envDefault:"90"`
+
+	// The token used to authorize the Discord bot.
+	DiscordToken string `env:"DISCORD_TOKEN,required"`
 
 	// A PostgreSQL instance used to store data for the bot.
 	//
@@ -23,6 +49,24 @@ type Config struct {
 		Port     int    `env:"PORT,required"`
 		Options  string `env:"OPTIONS"`
 	} `envPrefix:"DATABASE_"`
+
+	// A Redis instance used to cache Discord API responses.
+	// This should be the same instance the web service uses.
+	DiscordCache struct {
+		Host     string `env:"HOST,required"`
+		Password string `env:"PASSWORD"`
+		Port     int    `env:"PORT,required"`
+		DB       int    `env:"DB,required"`
+	} `envPrefix:"DISCORD_CACHE_"`
+
+	// The Redis instance the web service caches database data in, so it can be invalidated when tasks change the data.
+	// This should be the same instance the web service uses, when the host is empty the cached data expires on its own instead.
+	DatabaseCache struct {
+		Host     string `env:"HOST"`
+		Password string `env:"PASSWORD"`
+		Port     int    `env:"PORT"`
+		DB       int    `env:"DB"`
+	} `envPrefix:"DATABASE_CACHE_"`
 }
 
 var (
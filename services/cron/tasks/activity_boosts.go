@@ -0,0 +1,60 @@
+package tasks
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/typical-developers/discord-bot-backend/internal/cachekeys"
+	"github.com/typical-developers/discord-bot-backend/internal/db"
+)
+
+func boostFields(boost db.GuildActivityBoost) log.Fields {
+	return log.Fields{
+		"boost_id":      boost.BoostID,
+		"guild_id":      boost.GuildID,
+		"name":          boost.Name,
+		"activity_type": boost.GrantType,
+		"multiplier":    boost.Multiplier,
+		"starts_at":     boost.StartsAt,
+		"ends_at":       boost.EndsAt,
+	}
+}
+
+// invalidateActivityBoosts removes the cached settings of the guilds whose boosts changed and marks their cards as changed,
+// since both show the guild's activity boosts.
+func (t *Tasks) invalidateActivityBoosts(ctx context.Context, boosts ...db.GuildActivityBoost) {
+	settingsKeys := make([]string, 0, len(boosts))
+	versionKeys := make([]string, 0, len(boosts))
+	for _, boost := range boosts {
+		settingsKeys = append(settingsKeys, cachekeys.GuildSettings(boost.GuildID))
+		versionKeys = append(versionKeys, cachekeys.CardDataVersion(boost.GuildID))
+	}
+
+	t.c.Invalidate(ctx, settingsKeys...)
+	t.c.BumpVersion(ctx, versionKeys...)
+}
+
+// ProcessActivityBoosts logs when activity boosts start and end, then cleans up the boosts that have ended.
+func (t *Tasks) ProcessActivityBoosts(ctx context.Context) error {
+	started, err := t.q.StartActivityBoosts(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, boost := range started {
+		log.WithFields(boostFields(boost)).Info("An activity boost has started.")
+	}
+	t.invalidateActivityBoosts(ctx, started...)
+
+	expired, err := t.q.DeleteExpiredActivityBoosts(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, boost := range expired {
+		log.WithFields(boostFields(boost)).Info("An activity boost has ended and was cleaned up.")
+	}
+	t.invalidateActivityBoosts(ctx, expired...)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/typical-developers/discord-bot-backend/internal/db"
+)
+
+const (
+	// The amount of members whose old ledger entries are compacted in a single batch.
+	ledgerCompactBatchSize = 500
+
+	// The amount of batches compacted in a single run, the rest are picked up by the next run.
+	ledgerCompactBatchesPerRun = 100
+)
+
+// CompactActivityLedger replaces the activity ledger entries older than the retention with a single entry per member and activity type.
+// The compacted entry keeps the total of the entries it replaced, so the ledger still adds up to the member's points.
+func (t *Tasks) CompactActivityLedger(ctx context.Context) error {
+	before := int32(time.Now().Add(-t.ledgerRetention).Unix())
+
+	var compacted int64
+	for range ledgerCompactBatchesPerRun {
+		rows, err := t.q.CompactActivityLedger(ctx, db.CompactActivityLedgerParams{
+			Before:    before,
+			BatchSize: ledgerCompactBatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		compacted += rows
+		if rows < ledgerCompactBatchSize {
+			break
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"before":    before,
+		"compacted": compacted,
+	}).Info("Compacted the activity ledger.")
+
+	return nil
+}
@@ -35,7 +35,7 @@ func (t *Tasks) FlushWeeklyActivityLeaderboard(ctx context.Context) error {
 		return err
 	}
 
-	q := t.q.WithTx(tx)
+	q := t.q.InTx(tx)
 	if err := q.ArchiveWeeklyActivityLeaderboard(ctx); err != nil {
 		_ = tx.Rollback()
 		return err
@@ -88,7 +88,7 @@ func (t *Tasks) FlushMonthlyActivityLeaderboard(ctx context.Context) error {
 		return err
 	}
 
-	q := t.q.WithTx(tx)
+	q := t.q.InTx(tx)
 	if err := q.ArchiveMonthlyActivityLeaderboard(ctx); err != nil {
 		_ = tx.Rollback()
 		return err
@@ -0,0 +1,129 @@
+package tasks
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/typical-developers/discord-bot-backend/internal/db"
+)
+
+// activityBoostMultiplier resolves the highest boost that was active at the session's last update.
+// Boosts filtered by roles are skipped, since the member's roles aren't available here.
+func (t *Tasks) activityBoostMultiplier(ctx context.Context, session db.GuildVoiceSession) (float32, error) {
+	boosts, err := t.q.GetActiveActivityBoosts(ctx, db.GetActiveActivityBoostsParams{
+		GuildID:   session.GuildID,
+		GrantType: "voice",
+		At:        session.LastUpdate,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	multiplier := float32(1)
+	for _, boost := range boosts {
+		if len(boost.RoleIds) == 0 && boost.Multiplier > multiplier {
+			multiplier = boost.Multiplier
+		}
+	}
+
+	return multiplier, nil
+}
+
+// closeOrphanedVoiceSession closes the session at its last update and grants the points it had accrued up until then.
+func (t *Tasks) closeOrphanedVoiceSession(ctx context.Context, session db.GuildVoiceSession) (int32, error) {
+	settings, err := t.q.GetGuildVoiceActivitySettings(ctx, session.GuildID)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := t.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	q := t.q.InTx(tx)
+	closed, err := q.CloseVoiceSession(ctx, db.CloseVoiceSessionParams{
+		GuildID:  session.GuildID,
+		MemberID: session.MemberID,
+		ClosedAt: session.LastUpdate,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+
+	var points int32
+	if settings.IsEnabled {
+		boost, err := t.activityBoostMultiplier(ctx, session)
+		if err != nil {
+			_ = tx.Rollback()
+			return 0, err
+		}
+
+		points = int32(math.Round(float64((closed.ActiveSeconds/60)*settings.GrantAmount) * float64(boost)))
+	}
+
+	if points > 0 {
+		// The session's time is granted all at once, so the grant cooldown doesn't apply.
+		err = db.GrantActivityPoints(ctx, q, session.GuildID, session.MemberID, "voice", session.ChannelID, points, 0)
+
+		// The profile no longer exists, so the session is just discarded.
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, tx.Commit()
+		}
+
+		if err != nil {
+			_ = tx.Rollback()
+			return 0, err
+		}
+	}
+
+	return points, tx.Commit()
+}
+
+// ReconcileVoiceSessions closes voice sessions that haven't been updated within the timeout.
+// These are usually left behind when the web service restarts while members are in a voice channel.
+func (t *Tasks) ReconcileVoiceSessions(ctx context.Context) error {
+	sessions, err := t.q.GetStaleVoiceSessions(ctx, int32(time.Now().Add(-t.voiceSessionTimeout).Unix()))
+	if err != nil {
+		return err
+	}
+
+	if len(sessions) <= 0 {
+		log.Info("There are no orphaned voice sessions to reconcile.")
+		return nil
+	}
+
+	for _, session := range sessions {
+		logger := log.WithFields(log.Fields{
+			"guild_id":    session.GuildID,
+			"member_id":   session.MemberID,
+			"started_at":  session.StartedAt,
+			"last_update": session.LastUpdate,
+		})
+
+		points, err := t.closeOrphanedVoiceSession(ctx, session)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			// The web service closed the session after it was fetched, so there's nothing left to reconcile.
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+
+			// One session failing shouldn't stop the rest from being reconciled.
+			logger.WithError(err).Error("Failed to reconcile an orphaned voice session.")
+			continue
+		}
+
+		logger.WithField("points_granted", points).Info("Reconciled an orphaned voice session.")
+	}
+
+	return nil
+}
@@ -0,0 +1,263 @@
+package tasks
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+	"github.com/typical-developers/discord-bot-backend/internal/db"
+)
+
+const (
+	// The amount of profiles processed in a single batch.
+	resyncBatchSize = 100
+
+	// The amount of batches processed for a job in a single run, the rest are picked up by the next run.
+	resyncBatchesPerRun = 10
+
+	// How long a job is locked for while it's being processed, the lock is renewed after every batch.
+	resyncLockDuration = 5 * time.Minute
+)
+
+// errResyncJobTaken is returned when another run has claimed the job after its lock expired.
+var errResyncJobTaken = errors.New("the activity role resync job was claimed by another run")
+
+type activityRoleResync struct {
+	roles []db.GetGuildActivityRolesRow
+	mode  string
+}
+
+// desiredRoles splits the activity roles into the ones the member should and shouldn't have.
+func (r activityRoleResync) desiredRoles(points int32) (wanted []string, unwanted []string) {
+	earned := make([]string, 0)
+	for _, role := range r.roles {
+		if role.RequiredPoints.Int32 <= points {
+			earned = append(earned, role.RoleID)
+		}
+	}
+
+	wanted = earned
+	if r.mode == "replace" && len(earned) > 0 {
+		// The roles are ordered by their required points, so the last one is the highest.
+		wanted = earned[len(earned)-1:]
+	}
+
+	for _, role := range r.roles {
+		if !slices.Contains(wanted, role.RoleID) {
+			unwanted = append(unwanted, role.RoleID)
+		}
+	}
+
+	return wanted, unwanted
+}
+
+// syncMemberRoles applies the missing and extra activity roles to the member.
+func (t *Tasks) syncMemberRoles(ctx context.Context, guildId string, member *discordgo.Member, wanted []string, unwanted []string) (added int32, removed int32, err error) {
+	for _, roleId := range wanted {
+		if slices.Contains(member.Roles, roleId) {
+			continue
+		}
+
+		err := t.d.Session.GuildMemberRoleAdd(guildId, member.User.ID, roleId, discordgo.WithContext(ctx), discordgo.WithRetryOnRatelimit(true))
+		if err != nil {
+			return added, removed, err
+		}
+
+		added++
+	}
+
+	for _, roleId := range unwanted {
+		if !slices.Contains(member.Roles, roleId) {
+			continue
+		}
+
+		err := t.d.Session.GuildMemberRoleRemove(guildId, member.User.ID, roleId, discordgo.WithContext(ctx), discordgo.WithRetryOnRatelimit(true))
+		if err != nil {
+			return added, removed, err
+		}
+
+		removed++
+	}
+
+	return added, removed, nil
+}
+
+func (t *Tasks) activityRoleResync(ctx context.Context, guildId string, activityType string, mode string) (*activityRoleResync, error) {
+	roles, err := t.q.GetGuildActivityRoles(ctx, db.GetGuildActivityRolesParams{
+		GuildID:      guildId,
+		ActivityType: activityType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &activityRoleResync{roles: roles, mode: mode}, nil
+}
+
+// processResyncBatch resyncs the next batch of profiles for the job.
+// It returns false once there are no profiles left to process.
+func (t *Tasks) processResyncBatch(ctx context.Context, job *db.GuildActivityRoleResyncJob, chat *activityRoleResync, voice *activityRoleResync) (bool, error) {
+	profiles, err := t.q.GetActivityRoleResyncBatch(ctx, db.GetActivityRoleResyncBatchParams{
+		GuildID:       job.GuildID,
+		AfterMemberID: job.LastMemberID,
+		BatchSize:     resyncBatchSize,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if len(profiles) == 0 {
+		return false, nil
+	}
+
+	// A single member failing, i.e. because they have a role above the bot's, shouldn't stop everyone after them from being synced.
+	// Failures are logged and counted in the job's progress instead.
+	var added, removed, failed int32
+	for _, profile := range profiles {
+		logger := log.WithFields(log.Fields{
+			"job_id":    job.JobID,
+			"guild_id":  job.GuildID,
+			"member_id": profile.MemberID,
+		})
+
+		member, err := t.d.GuildMember(ctx, job.GuildID, profile.MemberID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return false, ctx.Err()
+			}
+
+			var dgErr *discordgo.RESTError
+			if errors.As(err, &dgErr) && dgErr.Message != nil && dgErr.Message.Code == discordgo.ErrCodeUnknownMember {
+				continue
+			}
+
+			logger.WithError(err).Warn("Failed to get the member for the activity role resync.")
+			failed++
+			continue
+		}
+
+		// Members that left are cached as null, which is the same as them being unknown.
+		if member == nil {
+			continue
+		}
+
+		chatWanted, chatUnwanted := chat.desiredRoles(profile.ChatActivity)
+		voiceWanted, voiceUnwanted := voice.desiredRoles(profile.VoiceActivity)
+
+		a, r, err := t.syncMemberRoles(ctx, job.GuildID, member,
+			append(chatWanted, voiceWanted...),
+			append(chatUnwanted, voiceUnwanted...),
+		)
+		added += a
+		removed += r
+		if err != nil {
+			if ctx.Err() != nil {
+				return false, ctx.Err()
+			}
+
+			logger.WithError(err).Warn("Failed to sync the member's activity roles.")
+			failed++
+		}
+	}
+
+	lastMemberId := profiles[len(profiles)-1].MemberID
+	rows, err := t.q.UpdateActivityRoleResyncJobProgress(ctx, db.UpdateActivityRoleResyncJobProgressParams{
+		JobID:            job.JobID,
+		Processed:        int32(len(profiles)),
+		Failed:           failed,
+		RolesAdded:       added,
+		RolesRemoved:     removed,
+		LastMemberID:     lastMemberId,
+		LockedUntil:      int32(time.Now().Add(resyncLockDuration).Unix()),
+		PreviousMemberID: job.LastMemberID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if rows == 0 {
+		return false, errResyncJobTaken
+	}
+	job.LastMemberID = lastMemberId
+
+	return true, nil
+}
+
+// ResyncActivityRoles processes queued activity role resync jobs in batches.
+func (t *Tasks) ResyncActivityRoles(ctx context.Context) error {
+	job, err := t.q.ClaimActivityRoleResyncJob(ctx, int32(time.Now().Add(resyncLockDuration).Unix()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug("There are no activity role resync jobs to process.")
+			return nil
+		}
+
+		return err
+	}
+
+	logger := log.WithFields(log.Fields{
+		"job_id":   job.JobID,
+		"guild_id": job.GuildID,
+	})
+
+	fail := func(err error) error {
+		logger.WithError(err).Error("The activity role resync job has failed.")
+
+		return t.q.FinishActivityRoleResyncJob(ctx, db.FinishActivityRoleResyncJobParams{
+			JobID:  job.JobID,
+			Status: "failed",
+			Error:  err.Error(),
+		})
+	}
+
+	chatSettings, err := t.q.GetGuildChatActivitySettings(ctx, job.GuildID)
+	if err != nil {
+		return fail(err)
+	}
+
+	voiceSettings, err := t.q.GetGuildVoiceActivitySettings(ctx, job.GuildID)
+	if err != nil {
+		return fail(err)
+	}
+
+	chat, err := t.activityRoleResync(ctx, job.GuildID, "chat", chatSettings.RoleAssignmentMode)
+	if err != nil {
+		return fail(err)
+	}
+
+	voice, err := t.activityRoleResync(ctx, job.GuildID, "voice", voiceSettings.RoleAssignmentMode)
+	if err != nil {
+		return fail(err)
+	}
+
+	for range resyncBatchesPerRun {
+		more, err := t.processResyncBatch(ctx, &job, chat, voice)
+		if errors.Is(err, errResyncJobTaken) {
+			logger.Warn("The activity role resync job took longer than its lock and was claimed by another run.")
+			return nil
+		}
+		if err != nil {
+			return fail(err)
+		}
+
+		if !more {
+			err := t.q.FinishActivityRoleResyncJob(ctx, db.FinishActivityRoleResyncJobParams{
+				JobID:  job.JobID,
+				Status: "completed",
+			})
+			if err != nil {
+				return err
+			}
+
+			logger.Info("The activity role resync job has completed.")
+			return nil
+		}
+	}
+
+	logger.WithField("last_member_id", job.LastMemberID).Info("The activity role resync job will continue on the next run.")
+	return t.q.ReleaseActivityRoleResyncJob(ctx, job.JobID)
+}
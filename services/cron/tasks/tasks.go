@@ -2,15 +2,25 @@ package tasks
 
 import (
 	"database/sql"
+	"time"
 
 	"github.com/typical-developers/discord-bot-backend/internal/db"
+	db_cache "github.com/typical-developers/discord-bot-backend/pkg/db-cache"
+	discord_state "github.com/typical-developers/discord-bot-backend/pkg/discord-state"
 )
 
 type Tasks struct {
 	db *sql.DB
-	q  *db.Queries
+	q  db.TxQuerier
+	d  *discord_state.StateManager
+
+	// The web service's database cache, this is nil when it isn't configured.
+	c *db_cache.Cache
+
+	voiceSessionTimeout time.Duration
+	ledgerRetention     time.Duration
 }
 
-func NewTasks(db *sql.DB, q *db.Queries) *Tasks {
-	return &Tasks{db: db, q: q}
+func NewTasks(db *sql.DB, q db.TxQuerier, d *discord_state.StateManager, c *db_cache.Cache, voiceSessionTimeout time.Duration, ledgerRetention time.Duration) *Tasks {
+	return &Tasks{db: db, q: q, d: d, c: c, voiceSessionTimeout: voiceSessionTimeout, ledgerRetention: ledgerRetention}
 }
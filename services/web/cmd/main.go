@@ -3,28 +3,43 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/go-chi/chi"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	_ "github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9"
 	"github.com/sirupsen/logrus"
 	log "github.com/sirupsen/logrus"
 	httpSwagger "github.com/swaggo/http-swagger"
 	"github.com/typical-developers/discord-bot-backend/internal/db"
-	_ "github.com/typical-developers/discord-bot-backend/internal/logger"
+	"github.com/typical-developers/discord-bot-backend/internal/logger"
+	"github.com/typical-developers/discord-bot-backend/internal/metrics"
+	"github.com/typical-developers/discord-bot-backend/internal/tracing"
+	db_cache "github.com/typical-developers/discord-bot-backend/pkg/db-cache"
 	discord_state "github.com/typical-developers/discord-bot-backend/pkg/discord-state"
+	"github.com/typical-developers/discord-bot-backend/pkg/ratelimit"
+	"github.com/typical-developers/discord-bot-backend/pkg/renderer"
 	"github.com/typical-developers/discord-bot-backend/services/web/config"
 	_ "github.com/typical-developers/discord-bot-backend/services/web/config"
 	_ "github.com/typical-developers/discord-bot-backend/services/web/docs"
+	"github.com/typical-developers/discord-bot-backend/services/web/gateway"
 	"github.com/typical-developers/discord-bot-backend/services/web/handlers"
 	"github.com/typical-developers/discord-bot-backend/services/web/usecase"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
+var errGatewayNotConnected = errors.New("the gateway is not connected")
+
 func dbConnect() (*sql.DB, error) {
 	db, err := sql.Open("postgres", fmt.Sprintf("postgres://%s:%s@%s:%d?%s",
 		config.C.Database.Username,
@@ -44,45 +59,105 @@ func dbConnect() (*sql.DB, error) {
 	return db, nil
 }
 
-func discordRedisConnect() (*redis.Client, error) {
+// redisConnect creates the redis client and periodically checks its connection until the context is done.
+func redisConnect(ctx context.Context, name string, host string, port int, password string, db int) (*redis.Client, error) {
 	var opts = &redis.Options{
-		Addr:     fmt.Sprintf("%s:%d", config.C.DiscordCache.Host, config.C.DiscordCache.Port),
-		Password: config.C.DiscordCache.Password,
-		DB:       config.C.DiscordCache.DB,
+		Addr:     fmt.Sprintf("%s:%d", host, port),
+		Password: password,
+		DB:       db,
 	}
 
 	client := redis.NewClient(opts)
+	logger := log.WithField("client", name)
+
+	if config.C.Tracing.Enabled {
+		if err := redisotel.InstrumentTracing(client); err != nil {
+			return nil, err
+		}
+	}
 
 	ticker := time.NewTicker(time.Second * 10)
 	go func() {
-		ctx := context.Background()
 		defer ticker.Stop()
 
 		healthy := true
-		for range ticker.C {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
 			_, err := client.Ping(ctx).Result()
 
 			if err != nil {
 				healthy = false
-				log.Warn("Redis client connection is not healthy.")
+				logger.Warn("Redis client connection is not healthy.")
 				continue
 			}
 
 			if !healthy {
 				healthy = true
-				log.Info("Redis client connection has been restored.")
+				logger.Info("Redis client connection has been restored.")
 				continue
 			}
 
-			log.Debug("Redis client connection is healthy.")
+			logger.Debug("Redis client connection is healthy.")
 		}
 	}()
 
 	return client, nil
 }
 
+// logCacheStats periodically logs the database cache's hits and misses until the context is done.
+func logCacheStats(ctx context.Context, cache *db_cache.Cache) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for kind, stats := range cache.Stats() {
+			log.WithFields(log.Fields{
+				"kind":   kind,
+				"hits":   stats.Hits,
+				"misses": stats.Misses,
+			}).Debug("Database cache stats.")
+		}
+	}
+}
+
+// The directory with the stylesheets, fonts and images used by the cards.
+const assetsDir = "./assets"
+
+var errUnknownCardRenderer = errors.New("the card renderer must be chromium or basic")
+
+// newCardRenderer creates the configured card renderer, which is nil when rendering cards to images is disabled.
+func newCardRenderer() (renderer.Renderer, error) {
+	switch config.C.CardRenderer.Kind {
+	case "":
+		return nil, nil
+	case "chromium":
+		return renderer.NewChromiumRenderer(&renderer.ChromiumOptions{
+			ExecPath:  config.C.CardRenderer.ChromiumPath,
+			AssetsDir: assetsDir,
+			MaxTabs:   config.C.CardRenderer.MaxTabs,
+		})
+	case "basic":
+		return renderer.NewBasicRenderer(&renderer.BasicOptions{
+			AssetsDir: assetsDir,
+		})
+	}
+
+	return nil, errUnknownCardRenderer
+}
+
 func serveStatic(r *chi.Mux) {
-	assetsRoot := http.Dir("./assets")
+	assetsRoot := http.Dir(assetsDir)
 	fs := http.StripPrefix("/static/", http.FileServer(assetsRoot))
 
 	r.Handle("/static/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -113,41 +188,73 @@ func serveStatic(r *chi.Mux) {
 //	@tag.name					HTML Generation
 //	@tag.description			HTML generation endpoints.
 //
+//	@tag.name					API Keys
+//	@tag.description			API key management endpoints.
+//
+//	@tag.name					Health
+//	@tag.description			Liveness and readiness endpoints.
+//
 //	@securitydefinitions.apikey	APIKeyAuth
 //	@in							header
 //	@name						X-API-KEY
 //
 // nolint:staticcheck
 func main() {
+	logger.SetFormat(config.C.LogFormat)
 	if lvl, err := logrus.ParseLevel(config.C.LogLevel); err != nil {
 		logrus.SetLevel(lvl)
 	}
 
-	router := chi.NewRouter()
-	router.Use(handlers.RequestLog)
-	router.Get("/docs/*", httpSwagger.Handler())
-	serveStatic(router)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
 	pqdb, err := dbConnect()
 	if err != nil {
 		panic(err)
 	}
 
-	querier := db.New(pqdb)
+	shutdownTracing := func(context.Context) error { return nil }
+	if config.C.Tracing.Enabled {
+		shutdownTracing, err = tracing.Setup(ctx, tracing.Options{
+			ServiceName: "discord-bot-web",
+			Endpoint:    config.C.Tracing.Endpoint,
+			SampleRatio: config.C.Tracing.SampleRatio,
+		})
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	var querier db.TxQuerier = db.New(pqdb)
+	if config.C.Tracing.Enabled {
+		querier = tracing.NewQuerier(querier)
+	}
 
 	discord, err := discordgo.New("Bot " + config.C.DiscordToken)
 	if err != nil {
 		panic(err)
 	}
 
+	if config.C.Tracing.Enabled {
+		discord.Client.Transport = otelhttp.NewTransport(discord.Client.Transport)
+	}
+
 	discord.Identify.Intents = discordgo.IntentsGuilds |
 		discordgo.IntentsGuildMessages
+	if config.C.VoiceSessionsFromGateway {
+		discord.Identify.Intents |= discordgo.IntentsGuildVoiceStates
+	}
 	err = discord.Open()
 	if err != nil {
 		panic(err)
 	}
 
-	discordCache, err := discordRedisConnect()
+	discordCache, err := redisConnect(ctx, "discord-cache",
+		config.C.DiscordCache.Host,
+		config.C.DiscordCache.Port,
+		config.C.DiscordCache.Password,
+		config.C.DiscordCache.DB,
+	)
 	if err != nil {
 		panic(err)
 	}
@@ -156,12 +263,169 @@ func main() {
 		RedisClient:    discordCache,
 	})
 
-	guildUsecase := usecase.NewGuildUsecase(pqdb, querier, discordState)
-	handlers.NewGuildHandler(router, guildUsecase)
+	databaseCache, err := redisConnect(ctx, "database-cache",
+		config.C.DatabaseCache.Host,
+		config.C.DatabaseCache.Port,
+		config.C.DatabaseCache.Password,
+		config.C.DatabaseCache.DB,
+	)
+	if err != nil {
+		panic(err)
+	}
+	dbCache := db_cache.NewCache(&db_cache.CacheOptions{
+		RedisClient: databaseCache,
+		TTL:         time.Duration(config.C.DatabaseCache.TTL) * time.Second,
+	})
+	go logCacheStats(ctx, dbCache)
+
+	metrics.Register(collectors.NewDBStatsCollector(pqdb, "postgres"), dbCache)
+	if config.C.MetricsPort != 0 {
+		go metrics.Serve(ctx, config.C.MetricsPort)
+	}
+
+	authUsecase := usecase.NewAuthUsecase(pqdb, querier, dbCache, config.C.AuthKey)
+	if config.C.Tracing.Enabled {
+		authUsecase = tracing.NewAuthUsecase(authUsecase)
+	}
+
+	var rateLimiter *handlers.RateLimiter
+	if config.C.RateLimit.Enabled {
+		rateLimiter = handlers.NewRateLimiter(
+			ratelimit.NewLimiter(&ratelimit.LimiterOptions{RedisClient: databaseCache}),
+			map[string]ratelimit.Limit{
+				handlers.RateLimitDefault:        {Rate: config.C.RateLimit.DefaultRate, Burst: config.C.RateLimit.DefaultBurst},
+				handlers.RateLimitActivityGrants: {Rate: config.C.RateLimit.ActivityGrantsRate, Burst: config.C.RateLimit.ActivityGrantsBurst},
+				handlers.RateLimitCards:          {Rate: config.C.RateLimit.CardsRate, Burst: config.C.RateLimit.CardsBurst},
+				handlers.RateLimitAPIKeys:        {Rate: config.C.RateLimit.APIKeysRate, Burst: config.C.RateLimit.APIKeysBurst},
+				handlers.RateLimitClients:        {Rate: config.C.RateLimit.ClientsRate, Burst: config.C.RateLimit.ClientsBurst},
+			},
+		)
+	}
+
+	router := chi.NewRouter()
+	if config.C.Tracing.Enabled {
+		router.Use(handlers.Tracing)
+	}
+	router.Use(handlers.RequestLog)
+	router.Use(handlers.RequestMetrics)
+	// The health endpoints are always public, since orchestrators don't send an API key.
+	router.Use(rateLimiter.Group(handlers.RateLimitClients))
+	router.Use(handlers.Authenticate(authUsecase, append(config.C.PublicRoutes, "/healthz", "/readyz")))
+	router.Use(handlers.Actor)
+	router.Get("/docs/*", httpSwagger.Handler())
+	serveStatic(router)
+
+	health := handlers.NewHealthHandler(router,
+		handlers.ReadinessCheck{Name: "postgres", Check: pqdb.PingContext},
+		handlers.ReadinessCheck{Name: "discord-cache", Check: func(ctx context.Context) error {
+			return discordCache.Ping(ctx).Err()
+		}},
+		handlers.ReadinessCheck{Name: "database-cache", Check: func(ctx context.Context) error {
+			return databaseCache.Ping(ctx).Err()
+		}},
+		handlers.ReadinessCheck{Name: "gateway", Check: func(ctx context.Context) error {
+			discord.RLock()
+			defer discord.RUnlock()
+
+			if !discord.DataReady {
+				return errGatewayNotConnected
+			}
+
+			return nil
+		}},
+	)
+
+	handlers.NewAuthHandler(router, authUsecase, rateLimiter)
+
+	cardRenderer, err := newCardRenderer()
+	if err != nil {
+		panic(err)
+	}
+
+	guildUsecase := usecase.NewGuildUsecase(pqdb, querier, discordState, dbCache, cardRenderer)
+	if config.C.Tracing.Enabled {
+		guildUsecase = tracing.NewGuildsUsecase(guildUsecase)
+	}
+	handlers.NewGuildHandler(router, guildUsecase, rateLimiter)
+
+	var chatGrants *usecase.ChatGrantBuffer
+	if config.C.ChatGrantBuffering {
+		chatGrants = usecase.NewChatGrantBuffer(pqdb, querier, time.Duration(config.C.ChatGrantFlushInterval)*time.Second)
+	}
+
+	memberUsecase := usecase.NewMemberUsecase(pqdb, querier, discordState, dbCache, cardRenderer, chatGrants)
+	if config.C.Tracing.Enabled {
+		memberUsecase = tracing.NewMemberUsecase(memberUsecase)
+	}
+	handlers.NewMemberHandler(router, memberUsecase, rateLimiter)
+
+	var voiceSessions *gateway.VoiceSessionTracker
+	if config.C.VoiceSessionsFromGateway {
+		voiceSessions = gateway.NewVoiceSessionTracker(ctx, discord, memberUsecase)
+	}
+
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", config.C.Port),
+		Handler: router,
+	}
+
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			panic(err)
+		}
+	}()
 
-	memberUsecase := usecase.NewMemberUsecase(pqdb, querier, discordState)
-	handlers.NewMemberHandler(router, memberUsecase)
+	<-ctx.Done()
+
+	log.Info("Shutting down.")
+
+	// Readiness starts failing first, giving the orchestrator time to stop routing traffic before the server stops.
+	health.ShuttingDown()
+	time.Sleep(time.Duration(config.C.ShutdownDelay) * time.Second)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Duration(config.C.ShutdownTimeout)*time.Second)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.WithError(err).Error("Failed to shut down the server.")
+	}
+
+	if voiceSessions != nil {
+		voiceSessions.Close()
+	}
+
+	// The server has stopped accepting grants at this point, so whatever is left in the buffer is the last of them.
+	if chatGrants != nil {
+		if err := chatGrants.Close(shutdownCtx); err != nil {
+			log.WithError(err).Error("Failed to flush the buffered chat activity grants.")
+		}
+	}
+
+	if cardRenderer != nil {
+		if err := cardRenderer.Close(); err != nil {
+			log.WithError(err).Error("Failed to close the card renderer.")
+		}
+	}
+
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		log.WithError(err).Error("Failed to flush the remaining traces.")
+	}
+
+	if err := discord.Close(); err != nil {
+		log.WithError(err).Error("Failed to close the Discord session.")
+	}
+
+	if err := pqdb.Close(); err != nil {
+		log.WithError(err).Error("Failed to close the database connection.")
+	}
+
+	if err := discordCache.Close(); err != nil {
+		log.WithError(err).Error("Failed to close the Discord cache connection.")
+	}
+
+	if err := databaseCache.Close(); err != nil {
+		log.WithError(err).Error("Failed to close the database cache connection.")
+	}
 
-	port := fmt.Sprintf(":%d", config.C.Port)
-	panic(http.ListenAndServe(port, router))
+	log.Info("Shut down.")
 }
@@ -11,13 +11,90 @@ type Config struct {
 	Port int `env:"PORT" envDefault:"8080"`
 
 	LogLevel string `env:"LOG_LEVEL" envDefault:"info"`
+	// Either "json" for one JSON object per line, or "text" for a human-readable format when running locally.
+	LogFormat string `env:"LOG_FORMAT" envDefault:"json"`
+
+	// The port to expose Prometheus metrics on, at /metrics.
+	// This is separate from the API's port so it isn't publicly reachable, setting it to 0 disables the metrics.
+	MetricsPort int `env:"METRICS_PORT" envDefault:"9090"`
+
+	// Exports OpenTelemetry traces over OTLP/HTTP, this is disabled by default.
+	//
+	// When the endpoint is empty, the standard OTEL_EXPORTER_OTLP_* environment variables are used.
+	// The sample ratio is the fraction of new traces that are sampled, between 0 and 1.
+	Tracing struct {
+		Enabled     bool    `env:"ENABLED" envDefault:"false"`
+		Endpoint    string  `env:"ENDPOINT"`
+		SampleRatio float64 `env:"SAMPLE_RATIO" envDefault:"1"`
+	} `envPrefix:"TRACING_"`
+
+	// How long, in seconds, to wait after readiness starts failing before the server stops accepting requests.
+	// This gives the orchestrator time to stop routing traffic to the service.
+	ShutdownDelay int `env:"SHUTDOWN_DELAY" envDefault:"5"`
+	// How long, in seconds, in-flight requests and buffered grants have to finish once the server is stopping.
+	ShutdownTimeout int `env:"SHUTDOWN_TIMEOUT" envDefault:"30"`
 
 	// The key used to authorize access to the API.
+	// This acts as a master key with every scope, additional keys are stored in the database.
 	AuthKey string `env:"AUTH_KEY,required"`
 
+	// Routes that can be accessed without an API key.
+	//
+	// Patterns ending with "/*" match everything under that prefix, otherwise "*" matches a single path segment.
+	// They should be formatted as an array, for example: "/static/*,/v1/guild/*/member/*/profile-card".
+	PublicRoutes []string `env:"PUBLIC_ROUTES" envSeparator:"," envDefault:"/static/*,/docs/*"`
+
+	// Limits requests per API key and guild with a token bucket, stored in the database cache.
+	// Requests from public routes are limited by the client's address instead of the API key.
+	//
+	// The rate is how many requests are allowed per second, and the burst is how many can be made at once.
+	// Activity grants covers the chat activity, voice activity and voice session endpoints.
+	// Cards covers the profile card and leaderboard card endpoints, since they make Discord requests.
+	// Default covers every other guild and member endpoint.
+	// API keys covers the API key management endpoints.
+	//
+	// Clients limits every request by the client's address before its API key is checked, so invalid keys are limited too.
+	// This has to be higher than the other limits, since every request from the bot comes from the same address.
+	RateLimit struct {
+		Enabled             bool    `env:"ENABLED" envDefault:"true"`
+		DefaultRate         float64 `env:"DEFAULT_RATE" envDefault:"10"`
+		DefaultBurst        int     `env:"DEFAULT_BURST" envDefault:"20"`
+		ActivityGrantsRate  float64 `env:"ACTIVITY_GRANTS_RATE" envDefault:"50"`
+		ActivityGrantsBurst int     `env:"ACTIVITY_GRANTS_BURST" envDefault:"100"`
+		CardsRate           float64 `env:"CARDS_RATE" envDefault:"2"`
+		CardsBurst          int     `env:"CARDS_BURST" envDefault:"10"`
+		APIKeysRate         float64 `env:"API_KEYS_RATE" envDefault:"1"`
+		APIKeysBurst        int     `env:"API_KEYS_BURST" envDefault:"5"`
+		ClientsRate         float64 `env:"CLIENTS_RATE" envDefault:"100"`
+		ClientsBurst        int     `env:"CLIENTS_BURST" envDefault:"200"`
+	} `envPrefix:"RATE_LIMIT_"`
+
+	// Renders the profile and leaderboard cards to images when they're requested with a format of png or webp.
+	//
+	// The renderer is either "chromium", which screenshots the cards with a local headless Chromium,
+	// or "basic", which only draws the cards' text and is meant for tests. It's disabled when it's empty.
+	// When the Chromium path is empty, the usual install locations and the PATH are searched.
+	// Max tabs is how many cards Chromium can render at once.
+	CardRenderer struct {
+		Kind         string `env:"KIND"`
+		ChromiumPath string `env:"CHROMIUM_PATH"`
+		MaxTabs      int    `env:"MAX_TABS" envDefault:"4"`
+	} `envPrefix:"CARD_RENDERER_"`
+
 	// The token used to authorize the Discord bot.
 	DiscordToken string `env:"DISCORD_TOKEN,required"`
 
+	// Opens and closes member voice sessions from the bot's gateway voice state updates.
+	// When this is disabled, voice sessions have to be managed through the API instead.
+	VoiceSessionsFromGateway bool `env:"VOICE_SESSIONS_FROM_GATEWAY" envDefault:"false"`
+
+	// Buffers chat activity grants in memory and writes them to the database in batches.
+	// Cooldowns are tracked in the database cache instead, and grants respond with 202 instead of the updated profile.
+	//
+	// The flush interval is in seconds.
+	ChatGrantBuffering     bool `env:"CHAT_GRANT_BUFFERING" envDefault:"false"`
+	ChatGrantFlushInterval int  `env:"CHAT_GRANT_FLUSH_INTERVAL" envDefault:"5"`
+
 	// A PostgreSQL instance used to store data for the bot.
 	//
 	// Options are query parameters used in the connection string.
@@ -31,11 +108,15 @@ type Config struct {
 	} `envPrefix:"DATABASE_"`
 
 	// A Redis instance used to store data fetched from the PostgreSQL database instance.
+	//
+	// The TTL is how long, in seconds, data is cached for before it is fetched again.
+	// Data is also removed from the cache whenever it is changed through the API.
 	DatabaseCache struct {
 		Host     string `env:"HOST,required"`
 		Password string `env:"PASSWORD"`
 		Port     int    `env:"PORT,required"`
 		DB       int    `env:"DB,required"`
+		TTL      int    `env:"TTL" envDefault:"300"`
 	} `envPrefix:"DATABASE_CACHE_"`
 
 	// A Redis instance  used to cache Discord API responses.
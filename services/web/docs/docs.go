@@ -15,8 +15,1444 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/healthz": {
+            "get": {
+                "tags": [
+                    "Health"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "tags": [
+                    "Health"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HealthResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/api-keys": {
+            "get": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "API Keys"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIKeysResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "API Keys"
+                ],
+                "parameters": [
+                    {
+                        "description": "The API key to create.",
+                        "name": "key",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIKeyCreateBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreatedAPIKeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/api-keys/{key_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "API Keys"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The API key ID.",
+                        "name": "key_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "API Keys"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The API key ID.",
+                        "name": "key_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The API key changes.",
+                        "name": "key",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIKeyUpdateBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIKeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/guild/{guild_id}/activity-adjustments": {
+            "post": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Members"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The user making the adjustment.",
+                        "name": "X-Actor-ID",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "The members to adjust and the reason for it.",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ActivityAdjustmentBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ActivityAdjustmentsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            }
+        },
         "/v1/guild/{guild_id}/activity-leaderboard-card": {
             "get": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "text/html",
+                    "image/png",
+                    "image/webp"
+                ],
+                "tags": [
+                    "Guilds"
+                ],
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The activity type.",
+                        "name": "activity_type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The time period.",
+                        "name": "time_period",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Renders the card to an image instead of HTML.",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The ETag of a previously fetched card, a 304 is returned when it hasn't changed.",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {}
+            }
+        },
+        "/v1/guild/{guild_id}/activity-leaderboard/periods": {
+            "get": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Guilds"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "chat",
+                            "voice"
+                        ],
+                        "type": "string",
+                        "description": "The activity type.",
+                        "name": "activity_type",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "weekly",
+                            "monthly"
+                        ],
+                        "type": "string",
+                        "description": "The time period.",
+                        "name": "time_period",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ArchivedLeaderboardPeriodsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/guild/{guild_id}/member/{member_id}": {
+            "get": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Members"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The member ID.",
+                        "name": "member_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {}
+            },
+            "post": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Members"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The member ID.",
+                        "name": "member_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {}
+            }
+        },
+        "/v1/guild/{guild_id}/member/{member_id}/activity-history": {
+            "get": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Members"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The member ID.",
+                        "name": "member_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "chat",
+                            "voice"
+                        ],
+                        "type": "string",
+                        "description": "The activity type.",
+                        "name": "activity_type",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 52,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "The amount of past weeks to include.",
+                        "name": "weeks",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 24,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "The amount of past months to include.",
+                        "name": "months",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.MemberActivityHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/guild/{guild_id}/member/{member_id}/activity-ledger": {
+            "get": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Members"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The member ID.",
+                        "name": "member_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "chat",
+                            "voice"
+                        ],
+                        "type": "string",
+                        "description": "The activity type, every activity type is included when it's omitted.",
+                        "name": "activity_type",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "The page of entries, from newest to oldest.",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.MemberActivityLedgerResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/guild/{guild_id}/member/{member_id}/chat-activity": {
+            "patch": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Members"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The member ID.",
+                        "name": "member_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The channel the message was sent in, used to apply channel multipliers.",
+                        "name": "channel_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Whether to create the member's profile when it doesn't exist, overrides the guild's setting.",
+                        "name": "create_profile",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ActivityGrantResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ActivityGrantResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APICooldownError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/guild/{guild_id}/member/{member_id}/migrate": {
+            "post": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Members"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The member ID.",
+                        "name": "member_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The migration body.",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.MigrateMemberProfileBody"
+                        }
+                    }
+                ],
+                "responses": {}
+            }
+        },
+        "/v1/guild/{guild_id}/member/{member_id}/profile-card": {
+            "get": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "text/html",
+                    "image/png",
+                    "image/webp"
+                ],
+                "tags": [
+                    "Members"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The member ID.",
+                        "name": "member_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Renders the card to an image instead of HTML.",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The ETag of a previously fetched card, a 304 is returned when it hasn't changed.",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {}
+            }
+        },
+        "/v1/guild/{guild_id}/member/{member_id}/voice-activity": {
+            "patch": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Members"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The member ID.",
+                        "name": "member_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Whether to create the member's profile when it doesn't exist, overrides the guild's setting.",
+                        "name": "create_profile",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ActivityGrantResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APICooldownError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/guild/{guild_id}/member/{member_id}/voice-session": {
+            "get": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Members"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The member ID.",
+                        "name": "member_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.VoiceSessionResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Members"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The member ID.",
+                        "name": "member_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Whether to create the member's profile when it doesn't exist, overrides the guild's setting.",
+                        "name": "create_profile",
+                        "in": "query"
+                    },
+                    {
+                        "description": "The member's current voice state.",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.VoiceSessionStateBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.VoiceSessionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Members"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The member ID.",
+                        "name": "member_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ClosedVoiceSessionResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Members"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The member ID.",
+                        "name": "member_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The member's current voice state.",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.VoiceSessionStateBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.VoiceSessionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/guild/{guild_id}/settings": {
+            "get": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Guilds"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GuildSettingsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Guilds"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GuildSettingsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/guild/{guild_id}/settings/activity": {
+            "patch": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Guilds"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The activity settings.",
+                        "name": "settings",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GuildActivitySettingsUpdateBody"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "The user making the change.",
+                        "name": "X-Actor-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GuildSettingsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/guild/{guild_id}/settings/activity-boosts": {
+            "get": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Guilds"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ActivityBoostsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Guilds"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The activity boost to schedule.",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ActivityBoostCreateBody"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "The user making the change.",
+                        "name": "X-Actor-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ActivityBoostResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/guild/{guild_id}/settings/activity-boosts/{boost_id}": {
+            "get": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Guilds"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The activity boost ID.",
+                        "name": "boost_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ActivityBoostResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Guilds"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The activity boost ID.",
+                        "name": "boost_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The user making the change.",
+                        "name": "X-Actor-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Guilds"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The activity boost ID.",
+                        "name": "boost_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The activity boost changes.",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ActivityBoostUpdateBody"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "The user making the change.",
+                        "name": "X-Actor-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ActivityBoostResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/guild/{guild_id}/settings/activity-roles": {
+            "get": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Guilds"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "chat",
+                            "voice"
+                        ],
+                        "type": "string",
+                        "description": "The activity type.",
+                        "name": "activity_type",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GuildActivityRolesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Guilds"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The activity role.",
+                        "name": "role",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GuildActivityRoleCreateBody"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "The user making the change.",
+                        "name": "X-Actor-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GuildActivityRoleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/guild/{guild_id}/settings/activity-roles/resync": {
+            "post": {
                 "security": [
                     {
                         "APIKeyAuth": []
@@ -25,7 +1461,6 @@ const docTemplate = `{
                 "tags": [
                     "Guilds"
                 ],
-                "deprecated": true,
                 "parameters": [
                     {
                         "type": "string",
@@ -33,29 +1468,87 @@ const docTemplate = `{
                         "name": "guild_id",
                         "in": "path",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ActivityRoleResyncJobResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/guild/{guild_id}/settings/activity-roles/resync/{job_id}": {
+            "get": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Guilds"
+                ],
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "The activity type.",
-                        "name": "activity_type",
-                        "in": "query",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "The time period.",
-                        "name": "time_period",
-                        "in": "query",
+                        "type": "integer",
+                        "description": "The resync job ID.",
+                        "name": "job_id",
+                        "in": "path",
                         "required": true
                     }
                 ],
-                "responses": {}
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.ActivityRoleResyncJobResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
             }
         },
-        "/v1/guild/{guild_id}/member/{member_id}": {
+        "/v1/guild/{guild_id}/settings/activity-roles/{role_id}": {
             "get": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
                 "tags": [
-                    "Members"
+                    "Guilds"
                 ],
                 "parameters": [
                     {
@@ -67,17 +1560,35 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "The member ID.",
-                        "name": "member_id",
+                        "description": "The role ID.",
+                        "name": "role_id",
                         "in": "path",
                         "required": true
                     }
                 ],
-                "responses": {}
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GuildActivityRoleResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
             },
-            "post": {
+            "delete": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
                 "tags": [
-                    "Members"
+                    "Guilds"
                 ],
                 "parameters": [
                     {
@@ -89,19 +1600,35 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "The member ID.",
-                        "name": "member_id",
+                        "description": "The role ID.",
+                        "name": "role_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The user making the change.",
+                        "name": "X-Actor-ID",
+                        "in": "header"
                     }
                 ],
-                "responses": {}
-            }
-        },
-        "/v1/guild/{guild_id}/member/{member_id}/chat-activity": {
+                "responses": {
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
+            },
             "patch": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
                 "tags": [
-                    "Members"
+                    "Guilds"
                 ],
                 "parameters": [
                     {
@@ -113,19 +1640,93 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "The member ID.",
-                        "name": "member_id",
+                        "description": "The role ID.",
+                        "name": "role_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "The activity role changes.",
+                        "name": "role",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GuildActivityRoleUpdateBody"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "The user making the change.",
+                        "name": "X-Actor-ID",
+                        "in": "header"
                     }
                 ],
-                "responses": {}
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GuildActivityRoleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
             }
         },
-        "/v1/guild/{guild_id}/member/{member_id}/migrate": {
-            "post": {
+        "/v1/guild/{guild_id}/settings/activity/channel-multipliers": {
+            "get": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
                 "tags": [
-                    "Members"
+                    "Guilds"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GuildActivityChannelMultipliersResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/guild/{guild_id}/settings/activity/channel-multipliers/{channel_id}": {
+            "put": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Guilds"
                 ],
                 "parameters": [
                     {
@@ -137,28 +1738,56 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "The member ID.",
-                        "name": "member_id",
+                        "description": "The channel or category ID.",
+                        "name": "channel_id",
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "description": "The migration body.",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
+                    {
+                        "description": "The multiplier, 0 excludes the channel.",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GuildActivityChannelMultiplierBody"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "The user making the change.",
+                        "name": "X-Actor-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GuildActivityChannelMultiplierResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/handlers.MigrateMemberProfileBody"
+                            "$ref": "#/definitions/handlers.APIError"
                         }
                     }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
                 ],
-                "responses": {}
-            }
-        },
-        "/v1/guild/{guild_id}/member/{member_id}/profile-card": {
-            "get": {
                 "tags": [
-                    "Members"
+                    "Guilds"
                 ],
                 "parameters": [
                     {
@@ -170,16 +1799,29 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "The member ID.",
-                        "name": "member_id",
+                        "description": "The channel or category ID.",
+                        "name": "channel_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The user making the change.",
+                        "name": "X-Actor-ID",
+                        "in": "header"
                     }
                 ],
-                "responses": {}
+                "responses": {
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
+                    }
+                }
             }
         },
-        "/v1/guild/{guild_id}/settings": {
+        "/v1/guild/{guild_id}/settings/activity/{activity_type}/deny-roles": {
             "get": {
                 "security": [
                     {
@@ -196,13 +1838,20 @@ const docTemplate = `{
                         "name": "guild_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The activity type.",
+                        "name": "activity_type",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.GuildSettingsResponse"
+                            "$ref": "#/definitions/handlers.GuildActivityDenyRolesResponse"
                         }
                     },
                     "400": {
@@ -235,13 +1884,35 @@ const docTemplate = `{
                         "name": "guild_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The activity type.",
+                        "name": "activity_type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The role to deny from earning activity points.",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GuildActivityDenyRoleBody"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "The user making the change.",
+                        "name": "X-Actor-ID",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.GuildSettingsResponse"
+                            "$ref": "#/definitions/handlers.GuildActivityDenyRolesResponse"
                         }
                     },
                     "400": {
@@ -249,12 +1920,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/handlers.APIError"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.APIError"
+                        }
                     }
                 }
             }
         },
-        "/v1/guild/{guild_id}/settings/activity": {
-            "patch": {
+        "/v1/guild/{guild_id}/settings/activity/{activity_type}/deny-roles/{role_id}": {
+            "delete": {
                 "security": [
                     {
                         "APIKeyAuth": []
@@ -272,20 +1949,31 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "The activity settings.",
-                        "name": "settings",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handlers.GuildActivitySettingsUpdateBody"
-                        }
+                        "type": "string",
+                        "description": "The activity type.",
+                        "name": "activity_type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The role ID.",
+                        "name": "role_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The user making the change.",
+                        "name": "X-Actor-ID",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.GuildSettingsResponse"
+                            "$ref": "#/definitions/handlers.GuildActivityDenyRolesResponse"
                         }
                     },
                     "400": {
@@ -303,8 +1991,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/guild/{guild_id}/settings/activity-roles": {
-            "post": {
+        "/v1/guild/{guild_id}/settings/audit-log": {
+            "get": {
                 "security": [
                     {
                         "APIKeyAuth": []
@@ -322,13 +2010,55 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "The activity settings.",
-                        "name": "role",
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "The page of entries, from newest to oldest.",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.GuildSettingsAuditLogResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/guild/{guild_id}/settings/audit-log/channel": {
+            "put": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Guilds"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The guild ID.",
+                        "name": "guild_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The channel that settings changes are posted to.",
+                        "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.GuildActivityRoleCreateBody"
+                            "$ref": "#/definitions/handlers.GuildAuditLogChannelBody"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "The user making the change.",
+                        "name": "X-Actor-ID",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -370,6 +2100,12 @@ const docTemplate = `{
                         "name": "guild_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The user making the change.",
+                        "name": "X-Actor-ID",
+                        "in": "header"
                     }
                 ],
                 "responses": {}
@@ -426,6 +2162,12 @@ const docTemplate = `{
                         "name": "origin_channel_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The user making the change.",
+                        "name": "X-Actor-ID",
+                        "in": "header"
                     }
                 ],
                 "responses": {}
@@ -453,6 +2195,12 @@ const docTemplate = `{
                         "name": "origin_channel_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The user making the change.",
+                        "name": "X-Actor-ID",
+                        "in": "header"
                     }
                 ],
                 "responses": {}
@@ -480,6 +2228,12 @@ const docTemplate = `{
                         "name": "origin_channel_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The user making the change.",
+                        "name": "X-Actor-ID",
+                        "in": "header"
                     }
                 ],
                 "responses": {}
@@ -628,6 +2382,18 @@ const docTemplate = `{
                         "name": "time_period",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The start of an archived weekly or monthly period, or previous for the last period.",
+                        "name": "period_start",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The ETag of a previously fetched card, a 304 is returned when it hasn't changed.",
+                        "name": "If-None-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {}
@@ -635,7 +2401,7 @@ const docTemplate = `{
         }
     },
     "definitions": {
-        "handlers.APIError": {
+        "handlers.APICooldownError": {
             "type": "object",
             "properties": {
                 "code": {
@@ -644,8 +2410,97 @@ const docTemplate = `{
                 "message": {
                     "type": "string"
                 },
-                "success": {
-                    "type": "boolean"
+                "remaining_seconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.APIError": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.APIKeyCreateBody": {
+            "type": "object"
+        },
+        "handlers.APIKeyResponse": {
+            "type": "object"
+        },
+        "handlers.APIKeyUpdateBody": {
+            "type": "object"
+        },
+        "handlers.APIKeysResponse": {
+            "type": "object"
+        },
+        "handlers.ActivityAdjustmentBody": {
+            "type": "object"
+        },
+        "handlers.ActivityAdjustmentsResponse": {
+            "type": "object"
+        },
+        "handlers.ActivityBoostCreateBody": {
+            "type": "object"
+        },
+        "handlers.ActivityBoostResponse": {
+            "type": "object"
+        },
+        "handlers.ActivityBoostUpdateBody": {
+            "type": "object"
+        },
+        "handlers.ActivityBoostsResponse": {
+            "type": "object"
+        },
+        "handlers.ActivityGrantResponse": {
+            "type": "object"
+        },
+        "handlers.ActivityRoleResyncJobResponse": {
+            "type": "object"
+        },
+        "handlers.ArchivedLeaderboardPeriodsResponse": {
+            "type": "object"
+        },
+        "handlers.ClosedVoiceSessionResponse": {
+            "type": "object"
+        },
+        "handlers.CreatedAPIKeyResponse": {
+            "type": "object"
+        },
+        "handlers.GuildActivityChannelMultiplierBody": {
+            "type": "object",
+            "properties": {
+                "multiplier": {
+                    "type": "number"
+                }
+            }
+        },
+        "handlers.GuildActivityChannelMultiplierResponse": {
+            "type": "object"
+        },
+        "handlers.GuildActivityChannelMultipliersResponse": {
+            "type": "object"
+        },
+        "handlers.GuildActivityDenyRoleBody": {
+            "type": "object",
+            "properties": {
+                "role_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.GuildActivityDenyRolesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
@@ -663,14 +2518,69 @@ const docTemplate = `{
                 }
             }
         },
+        "handlers.GuildActivityRoleResponse": {
+            "type": "object"
+        },
+        "handlers.GuildActivityRoleUpdateBody": {
+            "type": "object"
+        },
+        "handlers.GuildActivityRolesResponse": {
+            "type": "object"
+        },
         "handlers.GuildActivitySettingsUpdateBody": {
             "type": "object"
         },
+        "handlers.GuildAuditLogChannelBody": {
+            "type": "object",
+            "properties": {
+                "channel_id": {
+                    "description": "An empty channel ID stops settings changes from being posted.",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.GuildSettingsAuditLogResponse": {
+            "type": "object"
+        },
         "handlers.GuildSettingsResponse": {
             "type": "object"
         },
+        "handlers.HealthResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/handlers.HealthStatus"
+                }
+            }
+        },
+        "handlers.HealthStatus": {
+            "type": "object",
+            "properties": {
+                "checks": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.MemberActivityHistoryResponse": {
+            "type": "object"
+        },
+        "handlers.MemberActivityLedgerResponse": {
+            "type": "object"
+        },
         "handlers.MigrateMemberProfileBody": {
             "type": "object"
+        },
+        "handlers.VoiceSessionResponse": {
+            "type": "object"
+        },
+        "handlers.VoiceSessionStateBody": {
+            "type": "object"
         }
     },
     "securityDefinitions": {
@@ -700,6 +2610,14 @@ const docTemplate = `{
         {
             "description": "HTML generation endpoints.",
             "name": "HTML Generation"
+        },
+        {
+            "description": "API key management endpoints.",
+            "name": "API Keys"
+        },
+        {
+            "description": "Liveness and readiness endpoints.",
+            "name": "Health"
         }
     ]
 }`
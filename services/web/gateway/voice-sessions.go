@@ -0,0 +1,189 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+	u "github.com/typical-developers/discord-bot-backend/internal/usecase"
+)
+
+// The interval that open voice sessions are refreshed at.
+// This needs to be shorter than the cron service's voice session timeout, otherwise sessions will be reconciled as orphaned.
+const voiceSessionRefreshInterval = time.Minute
+
+type VoiceSessionTracker struct {
+	s  *discordgo.Session
+	uc u.MemberUsecase
+
+	removeHandler func()
+	cancel        context.CancelFunc
+	done          chan struct{}
+}
+
+// NewVoiceSessionTracker drives member voice sessions from the gateway's voice state updates.
+// Sessions stop being refreshed once the context is done or the tracker is closed.
+//
+// The session needs the GuildVoiceStates intent for this to receive anything.
+func NewVoiceSessionTracker(ctx context.Context, s *discordgo.Session, uc u.MemberUsecase) *VoiceSessionTracker {
+	ctx, cancel := context.WithCancel(ctx)
+	t := &VoiceSessionTracker{
+		s:      s,
+		uc:     uc,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	t.removeHandler = s.AddHandler(t.onVoiceStateUpdate)
+	go t.refresh(ctx)
+
+	return t
+}
+
+// Close stops handling voice state updates and waits for the current refresh to finish.
+func (t *VoiceSessionTracker) Close() {
+	t.removeHandler()
+	t.cancel()
+	<-t.done
+}
+
+// isAlone checks if there are no other members (excluding bots) in the voice channel.
+func (t *VoiceSessionTracker) isAlone(guild *discordgo.Guild, userId string, channelId string) bool {
+	t.s.State.RLock()
+	defer t.s.State.RUnlock()
+
+	for _, vs := range guild.VoiceStates {
+		if vs.UserID == userId || vs.ChannelID != channelId {
+			continue
+		}
+
+		if vs.Member != nil && vs.Member.User != nil && vs.Member.User.Bot {
+			continue
+		}
+
+		return false
+	}
+
+	return true
+}
+
+// syncMember opens, updates or closes the member's voice session based on their current voice state.
+func (t *VoiceSessionTracker) syncMember(ctx context.Context, guildId string, userId string) {
+	logger := log.WithFields(log.Fields{
+		"guild_id":  guildId,
+		"member_id": userId,
+	})
+
+	guild, err := t.s.State.Guild(guildId)
+	if err != nil {
+		return
+	}
+
+	vs, err := t.s.State.VoiceState(guildId, userId)
+	if err != nil || vs.ChannelID == "" {
+		_, err := t.uc.CloseMemberVoiceSession(ctx, guildId, userId)
+		if err != nil && !errors.Is(err, u.ErrVoiceSessionNotFound) {
+			logger.WithError(err).Error("Failed to close voice session.")
+		}
+
+		return
+	}
+
+	if vs.Member != nil && vs.Member.User != nil && vs.Member.User.Bot {
+		return
+	}
+
+	state := u.VoiceSessionState{
+		ChannelID:  vs.ChannelID,
+		IsMuted:    vs.Mute || vs.SelfMute,
+		IsDeafened: vs.Deaf || vs.SelfDeaf,
+		IsAlone:    t.isAlone(guild, userId, vs.ChannelID),
+	}
+
+	_, err = t.uc.UpdateMemberVoiceSession(ctx, guildId, userId, state)
+	if errors.Is(err, u.ErrVoiceSessionNotFound) {
+		_, err = t.uc.OpenMemberVoiceSession(ctx, guildId, userId, state, nil)
+	}
+
+	if err != nil {
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logger.WithField("code", ueErr.Code).Debug("Voice session was not opened.")
+			return
+		}
+
+		logger.WithError(err).Error("Failed to sync voice session.")
+	}
+}
+
+// syncChannel syncs every member in the voice channel, since one member joining or leaving changes whether the others are alone.
+func (t *VoiceSessionTracker) syncChannel(ctx context.Context, guildId string, channelId string, excludeUserId string) {
+	guild, err := t.s.State.Guild(guildId)
+	if err != nil {
+		return
+	}
+
+	t.s.State.RLock()
+	userIds := make([]string, 0)
+	for _, vs := range guild.VoiceStates {
+		if vs.ChannelID == channelId && vs.UserID != excludeUserId {
+			userIds = append(userIds, vs.UserID)
+		}
+	}
+	t.s.State.RUnlock()
+
+	for _, userId := range userIds {
+		t.syncMember(ctx, guildId, userId)
+	}
+}
+
+func (t *VoiceSessionTracker) onVoiceStateUpdate(s *discordgo.Session, e *discordgo.VoiceStateUpdate) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	t.syncMember(ctx, e.GuildID, e.UserID)
+
+	if e.ChannelID != "" {
+		t.syncChannel(ctx, e.GuildID, e.ChannelID, e.UserID)
+	}
+
+	if e.BeforeUpdate != nil && e.BeforeUpdate.ChannelID != "" && e.BeforeUpdate.ChannelID != e.ChannelID {
+		t.syncChannel(ctx, e.GuildID, e.BeforeUpdate.ChannelID, e.UserID)
+	}
+}
+
+// refresh periodically syncs every member that is currently in a voice channel.
+// This keeps their sessions from being reconciled as orphaned and picks up any voice state updates that were missed.
+func (t *VoiceSessionTracker) refresh(ctx context.Context) {
+	defer close(t.done)
+
+	ticker := time.NewTicker(voiceSessionRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		type member struct{ guildId, userId string }
+
+		t.s.State.RLock()
+		members := make([]member, 0)
+		for _, guild := range t.s.State.Guilds {
+			for _, vs := range guild.VoiceStates {
+				members = append(members, member{guildId: guild.ID, userId: vs.UserID})
+			}
+		}
+		t.s.State.RUnlock()
+
+		refreshCtx, cancel := context.WithTimeout(ctx, voiceSessionRefreshInterval)
+		for _, m := range members {
+			t.syncMember(refreshCtx, m.guildId, m.userId)
+		}
+		cancel()
+	}
+}
@@ -0,0 +1,299 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	log "github.com/sirupsen/logrus"
+	u "github.com/typical-developers/discord-bot-backend/internal/usecase"
+	"github.com/typical-developers/discord-bot-backend/pkg/httpx"
+)
+
+type AuthHandler struct {
+	uc u.AuthUsecase
+}
+
+func NewAuthHandler(r *chi.Mux, uc u.AuthUsecase, rl *RateLimiter) {
+	h := AuthHandler{uc: uc}
+
+	r.Route("/v1/api-keys", func(r chi.Router) {
+		r.Use(requireAdmin, rl.Group(RateLimitAPIKeys))
+
+		r.Get("/", h.GetAPIKeys)
+		r.Post("/", h.CreateAPIKey)
+		r.Patch("/{keyId}", h.UpdateAPIKey)
+		r.Delete("/{keyId}", h.DeleteAPIKey)
+	})
+}
+
+//	@Router		/v1/api-keys [GET]
+//	@Tags		API Keys
+//
+//	@Security	APIKeyAuth
+//
+//	@Success	200	{object}	APIKeysResponse
+//	@Failure	401	{object}	APIError
+//	@Failure	403	{object}	APIError
+//
+// nolint:staticcheck
+func (h *AuthHandler) GetAPIKeys(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	keys, err := h.uc.GetAPIKeys(ctx)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, APIKeysResponse{
+		Data: keys,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/api-keys [POST]
+//	@Tags		API Keys
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		key	body		APIKeyCreateBody	true	"The API key to create."
+//
+//	@Success	201	{object}	CreatedAPIKeyResponse
+//	@Failure	400	{object}	APIError
+//	@Failure	401	{object}	APIError
+//	@Failure	403	{object}	APIError
+//
+// nolint:staticcheck
+func (h *AuthHandler) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var body *APIKeyCreateBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: ErrInvalidRequestBody.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	key, err := h.uc.CreateAPIKey(ctx, u.CreateAPIKeyOpts{
+		Name:      body.Name,
+		Scopes:    body.Scopes,
+		ExpiresAt: body.ExpiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrAPIKeyInvalidScope.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusBadRequest)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, CreatedAPIKeyResponse{
+		Data: *key,
+	}, http.StatusCreated)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/api-keys/{key_id} [PATCH]
+//	@Tags		API Keys
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		key_id	path		string				true	"The API key ID."
+//	@Param		key		body		APIKeyUpdateBody	true	"The API key changes."
+//
+//	@Success	200		{object}	APIKeyResponse
+//	@Failure	400		{object}	APIError
+//	@Failure	404		{object}	APIError
+//
+// nolint:staticcheck
+func (h *AuthHandler) UpdateAPIKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	keyId := chi.URLParam(r, "keyId")
+	var body *APIKeyUpdateBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: ErrInvalidRequestBody.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+	if err := body.Validate(); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	key, err := h.uc.UpdateAPIKey(ctx, keyId, u.UpdateAPIKeyOpts{
+		Name:      body.Name,
+		ExpiresAt: body.ExpiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrAPIKeyNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, APIKeyResponse{
+		Data: *key,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/api-keys/{key_id} [DELETE]
+//	@Tags		API Keys
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		key_id	path		string	true	"The API key ID."
+//
+//	@Failure	404		{object}	APIError
+//
+// nolint:staticcheck
+func (h *AuthHandler) DeleteAPIKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	keyId := chi.URLParam(r, "keyId")
+	err := h.uc.DeleteAPIKey(ctx, keyId)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrAPIKeyNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, APIResponse[any]{
+		Data: nil,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	u "github.com/typical-developers/discord-bot-backend/internal/usecase"
+)
+
+// etagMatches checks the If-None-Match header against the ETag.
+// The comparison is weak, since cards are only ever given weak ETags.
+func etagMatches(ifNoneMatch string, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setCardETag sets the card's ETag on a successful response.
+// Cards can be stored by clients and CDNs, but they have to be revalidated every time they're used.
+func setCardETag(w http.ResponseWriter, etag string) {
+	if etag == "" {
+		return
+	}
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+}
+
+// cardNotModified writes 304 when the client's copy of the card is still current.
+func cardNotModified(w http.ResponseWriter, r *http.Request, etag string) bool {
+	if etag == "" || !etagMatches(r.Header.Get("If-None-Match"), etag) {
+		return false
+	}
+
+	setCardETag(w, etag)
+	w.WriteHeader(http.StatusNotModified)
+	return true
+}
+
+// writeCard writes a card that was rendered to HTML or an image.
+func writeCard(w http.ResponseWriter, r *http.Request, card *u.RenderedCard, etag string) {
+	setCardETag(w, etag)
+	w.Header().Set("Content-Type", card.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(card.Data)))
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(card.Data); err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
@@ -17,36 +17,63 @@ type GuildHandler struct {
 	uc u.GuildsUsecase
 }
 
-func NewGuildHandler(r *chi.Mux, uc u.GuildsUsecase) {
+func NewGuildHandler(r *chi.Mux, uc u.GuildsUsecase, rl *RateLimiter) {
 	h := GuildHandler{uc: uc}
 
-	r.Route("/v1/guild/{guildId}", func(r chi.Router) {
-		r.Get("/settings", h.GetGuildSettings)
-		r.Post("/settings", h.CreateGuildSettings)
-		r.Patch("/settings/activity", h.UpdateGuildActivitySettings)
-		r.Post("/settings/activity-roles", h.CreateActivityRole)
-
-		r.Patch("/settings/message-embeds", h.UpdateGuildMessageEmbedSettings)
+	limitDefault := rl.Group(RateLimitDefault)
+	limitCards := rl.Group(RateLimitCards)
 
-		r.Get("/activity-leaderboard-card", h.GenerateGuildActivityLeaderboardCard)
+	r.Route("/v1/guild/{guildId}", func(r chi.Router) {
+		r.With(requireRead, limitDefault).Get("/settings", h.GetGuildSettings)
+		r.With(requireSettingsWrite, limitDefault).Post("/settings", h.CreateGuildSettings)
+		r.With(requireSettingsWrite, limitDefault).Patch("/settings/activity", h.UpdateGuildActivitySettings)
+		r.With(requireRead, limitDefault).Get("/settings/activity-roles", h.GetActivityRoles)
+		r.With(requireSettingsWrite, limitDefault).Post("/settings/activity-roles", h.CreateActivityRole)
+		r.With(requireRead, limitDefault).Get("/settings/activity-roles/{roleId}", h.GetActivityRole)
+		r.With(requireSettingsWrite, limitDefault).Patch("/settings/activity-roles/{roleId}", h.UpdateActivityRole)
+		r.With(requireSettingsWrite, limitDefault).Delete("/settings/activity-roles/{roleId}", h.DeleteActivityRole)
+		r.With(requireSettingsWrite, limitDefault).Post("/settings/activity-roles/resync", h.EnqueueActivityRoleResync)
+		r.With(requireRead, limitDefault).Get("/settings/activity-roles/resync/{jobId}", h.GetActivityRoleResyncJob)
+
+		r.With(requireRead, limitDefault).Get("/settings/activity/{activityType}/deny-roles", h.GetActivityDenyRoles)
+		r.With(requireSettingsWrite, limitDefault).Post("/settings/activity/{activityType}/deny-roles", h.AddActivityDenyRole)
+		r.With(requireSettingsWrite, limitDefault).Delete("/settings/activity/{activityType}/deny-roles/{roleId}", h.RemoveActivityDenyRole)
+
+		r.With(requireRead, limitDefault).Get("/settings/activity/channel-multipliers", h.GetChatActivityChannelMultipliers)
+		r.With(requireSettingsWrite, limitDefault).Put("/settings/activity/channel-multipliers/{channelId}", h.SetChatActivityChannelMultiplier)
+		r.With(requireSettingsWrite, limitDefault).Delete("/settings/activity/channel-multipliers/{channelId}", h.DeleteChatActivityChannelMultiplier)
+
+		r.With(requireRead, limitDefault).Get("/settings/activity-boosts", h.GetActivityBoosts)
+		r.With(requireSettingsWrite, limitDefault).Post("/settings/activity-boosts", h.CreateActivityBoost)
+		r.With(requireRead, limitDefault).Get("/settings/activity-boosts/{boostId}", h.GetActivityBoost)
+		r.With(requireSettingsWrite, limitDefault).Patch("/settings/activity-boosts/{boostId}", h.UpdateActivityBoost)
+		r.With(requireSettingsWrite, limitDefault).Delete("/settings/activity-boosts/{boostId}", h.DeleteActivityBoost)
+
+		r.With(requireSettingsWrite, limitDefault).Patch("/settings/message-embeds", h.UpdateGuildMessageEmbedSettings)
+
+		r.With(requireRead, limitDefault).Get("/settings/audit-log", h.GetSettingsAuditLog)
+		r.With(requireSettingsWrite, limitDefault).Put("/settings/audit-log/channel", h.UpdateAuditLogChannel)
+
+		r.With(requireHTML, limitCards).Get("/activity-leaderboard-card", h.GenerateGuildActivityLeaderboardCard)
+		r.With(requireRead, limitDefault).Get("/activity-leaderboard/periods", h.GetArchivedLeaderboardPeriods)
 
 		r.Route("/voice-room-lobby/{originChannelId}", func(r chi.Router) {
-			r.Post("/", h.CreateVoiceRoomLobby)
-			r.Get("/", h.GetVoiceRoomLobby)
-			r.Patch("/", h.UpdateVoiceRoomLobby)
-			r.Delete("/", h.DeleteVoiceRoomLobby)
-			r.Post("/register", h.RegisterVoiceRoom)
+			r.With(requireSettingsWrite, limitDefault).Post("/", h.CreateVoiceRoomLobby)
+			r.With(requireRead, limitDefault).Get("/", h.GetVoiceRoomLobby)
+			r.With(requireSettingsWrite, limitDefault).Patch("/", h.UpdateVoiceRoomLobby)
+			r.With(requireSettingsWrite, limitDefault).Delete("/", h.DeleteVoiceRoomLobby)
+			r.With(requireSettingsWrite, limitDefault).Post("/register", h.RegisterVoiceRoom)
 		})
 
 		r.Route("/voice-room/{channelId}", func(r chi.Router) {
-			r.Get("/", h.GetVoiceRoom)
-			r.Patch("/", h.UpdateVoiceRoom)
-			r.Delete("/", h.DeleteVoiceRoom)
+			r.With(requireRead, limitDefault).Get("/", h.GetVoiceRoom)
+			r.With(requireSettingsWrite, limitDefault).Patch("/", h.UpdateVoiceRoom)
+			r.With(requireSettingsWrite, limitDefault).Delete("/", h.DeleteVoiceRoom)
 		})
 	})
 
 	r.Route("/v2/guild/{guildId}", func(r chi.Router) {
-		r.Get("/activity-leaderboard", h.GetGuildActivityLeaderboard)
+		r.With(requireHTML, limitCards).Get("/activity-leaderboard", h.GetGuildActivityLeaderboard)
 	})
 }
 
@@ -79,6 +106,8 @@ func (h *GuildHandler) CreateGuildSettings(w http.ResponseWriter, r *http.Reques
 
 		var ueErr u.UsecaseError
 		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
 			var writeErr error
 
 			switch ueErr.Code {
@@ -89,45 +118,1490 @@ func (h *GuildHandler) CreateGuildSettings(w http.ResponseWriter, r *http.Reques
 				}, http.StatusConflict)
 			}
 
-			if writeErr != nil {
-				log.Error(writeErr)
-				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
-			}
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, GuildSettingsResponse{
+		Data: *settings,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/settings [GET]
+//	@Tags		Guilds
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id	path		string	true	"The guild ID."
+//
+//	@Success	200			{object}	GuildSettingsResponse
+//	@Failure	400			{object}	APIError
+//	@Failure	404			{object}	APIError
+//
+// nolint:staticcheck
+func (h *GuildHandler) GetGuildSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	guildId := chi.URLParam(r, "guildId")
+	settings, err := h.uc.GetGuildSettings(ctx, guildId)
+
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrGuildNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, GuildSettingsResponse{
+		Data: *settings,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/settings/activity  [PATCH]
+//	@Tags		Guilds
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id	path		string							true	"The guild ID."
+//	@Param		settings	body		GuildActivitySettingsUpdateBody	true	"The activity settings."
+//	@Param		X-Actor-ID	header		string							false	"The user making the change."
+//
+//	@Success	200			{object}	GuildSettingsResponse
+//	@Failure	400			{object}	APIError
+//	@Failure	404			{object}	APIError
+//
+// nolint:staticcheck
+func (h *GuildHandler) UpdateGuildActivitySettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	guildId := chi.URLParam(r, "guildId")
+	var updateBody *GuildActivitySettingsUpdateBody
+	if err := json.NewDecoder(r.Body).Decode(&updateBody); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: ErrInvalidRequestBody.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+	if err := updateBody.Validate(); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	settings, err := h.uc.UpdateGuildActivitySettings(ctx, guildId, u.UpdateAcitivtySettings{
+		ChatActivity:  updateBody.ChatActivity,
+		VoiceActivity: updateBody.VoiceActivity,
+	})
+
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrGuildNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, GuildSettingsResponse{
+		Data: *settings,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/settings/activity-roles [GET]
+//	@Tags		Guilds
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id		path		string	true	"The guild ID."
+//	@Param		activity_type	query		string	true	"The activity type."	Enums(chat, voice)
+//
+//	@Success	200				{object}	GuildActivityRolesResponse
+//	@Failure	400				{object}	APIError
+//
+// nolint:staticcheck
+func (h *GuildHandler) GetActivityRoles(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	guildId := chi.URLParam(r, "guildId")
+	activityType := r.URL.Query().Get("activity_type")
+
+	roles, err := h.uc.GetActivityRoles(ctx, guildId, activityType)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrInvalidActivityType.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusBadRequest)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, GuildActivityRolesResponse{
+		Data: roles,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/settings/activity-roles [POST]
+//	@Tags		Guilds
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id	path		string						true	"The guild ID."
+//	@Param		role		body		GuildActivityRoleCreateBody	true	"The activity role."
+//	@Param		X-Actor-ID	header		string						false	"The user making the change."
+//
+//	@Success	201			{object}	GuildActivityRoleResponse
+//	@Failure	400			{object}	APIError
+//	@Failure	404			{object}	APIError
+//	@Failure	409			{object}	APIError
+//
+// nolint:staticcheck
+func (h *GuildHandler) CreateActivityRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	guildId := chi.URLParam(r, "guildId")
+	var body *GuildActivityRoleCreateBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: ErrInvalidRequestBody.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	if err := body.Validate(); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	role, err := h.uc.CreateActivityRole(ctx, guildId, body.ActivityType, body.RoleID, body.RequiredPoints)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrInvalidActivityType.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusBadRequest)
+			case u.ErrGuildNotFound.Code:
+				fallthrough
+			case u.ErrGuildRoleNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			case u.ErrActivityRoleExists.Code:
+				fallthrough
+			case u.ErrActivityRoleDuplicateThreshold.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusConflict)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, GuildActivityRoleResponse{
+		Data: *role,
+	}, http.StatusCreated)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/settings/activity-roles/{role_id} [GET]
+//	@Tags		Guilds
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id	path		string	true	"The guild ID."
+//	@Param		role_id		path		string	true	"The role ID."
+//
+//	@Success	200			{object}	GuildActivityRoleResponse
+//	@Failure	404			{object}	APIError
+//
+// nolint:staticcheck
+func (h *GuildHandler) GetActivityRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	guildId := chi.URLParam(r, "guildId")
+	roleId := chi.URLParam(r, "roleId")
+
+	role, err := h.uc.GetActivityRole(ctx, guildId, roleId)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrActivityRoleNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, GuildActivityRoleResponse{
+		Data: *role,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/settings/activity-roles/{role_id} [PATCH]
+//	@Tags		Guilds
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id	path		string						true	"The guild ID."
+//	@Param		role_id		path		string						true	"The role ID."
+//	@Param		role		body		GuildActivityRoleUpdateBody	true	"The activity role changes."
+//	@Param		X-Actor-ID	header		string						false	"The user making the change."
+//
+//	@Success	200			{object}	GuildActivityRoleResponse
+//	@Failure	400			{object}	APIError
+//	@Failure	404			{object}	APIError
+//	@Failure	409			{object}	APIError
+//
+// nolint:staticcheck
+func (h *GuildHandler) UpdateActivityRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	guildId := chi.URLParam(r, "guildId")
+	roleId := chi.URLParam(r, "roleId")
+	var body *GuildActivityRoleUpdateBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: ErrInvalidRequestBody.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	if err := body.Validate(); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	role, err := h.uc.UpdateActivityRole(ctx, guildId, roleId, u.UpdateActivityRoleOpts(*body))
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrInvalidActivityType.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusBadRequest)
+			case u.ErrActivityRoleNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			case u.ErrActivityRoleDuplicateThreshold.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusConflict)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, GuildActivityRoleResponse{
+		Data: *role,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/settings/activity-roles/{role_id} [DELETE]
+//	@Tags		Guilds
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id	path		string	true	"The guild ID."
+//	@Param		role_id		path		string	true	"The role ID."
+//	@Param		X-Actor-ID	header		string	false	"The user making the change."
+//
+//	@Failure	404			{object}	APIError
+//
+// nolint:staticcheck
+func (h *GuildHandler) DeleteActivityRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	guildId := chi.URLParam(r, "guildId")
+	roleId := chi.URLParam(r, "roleId")
+
+	err := h.uc.DeleteActivityRole(ctx, guildId, roleId)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrActivityRoleNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, APIResponse[any]{
+		Data: nil,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/settings/message-embeds [POST]
+//	@Tags		Guilds
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id	path	string	true	"The guild ID."
+//	@Param		X-Actor-ID	header	string	false	"The user making the change."
+//
+// nolint:staticcheck
+func (h *GuildHandler) UpdateGuildMessageEmbedSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	guildId := chi.URLParam(r, "guildId")
+	var body *GuildMessageEmbedSettingsUpdateBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: ErrInvalidRequestBody.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	if err := body.Validate(); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	settings, err := h.uc.UpdateMessageEmbedSettings(ctx, guildId, u.UpdateMessageEmbedSettingsOpts{
+		IsEnabled:             body.IsEnabled,
+		AddDisabledChannel:    body.AddDisabledChannel,
+		RemoveDisabledChannel: body.RemoveDisabledChannel,
+		AddIgnoredChannel:     body.AddIgnoredChannel,
+		RemoveIgnoredChannel:  body.RemoveIgnoredChannel,
+		AddIgnoredRole:        body.AddIgnoredRole,
+		RemoveIgnoredRole:     body.RemoveIgnoredRole,
+	})
+
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrGuildNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, GuildSettingsResponse{
+		Data: *settings,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/settings/audit-log [GET]
+//	@Tags		Guilds
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id	path		string	true	"The guild ID."
+//	@Param		page		query		int		false	"The page of entries, from newest to oldest."	minimum(1)
+//
+//	@Success	200			{object}	GuildSettingsAuditLogResponse
+//
+// nolint:staticcheck
+func (h *GuildHandler) GetSettingsAuditLog(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	guildId := chi.URLParam(r, "guildId")
+	page, err := strconv.Atoi(httpx.GetQueryParam(r, "page", "1"))
+	if err != nil {
+		page = 1
+	}
+
+	auditLog, err := h.uc.GetSettingsAuditLog(ctx, guildId, page)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, GuildSettingsAuditLogResponse{
+		Data: *auditLog,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/settings/audit-log/channel [PUT]
+//	@Tags		Guilds
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id	path		string						true	"The guild ID."
+//	@Param		body		body		GuildAuditLogChannelBody	true	"The channel that settings changes are posted to."
+//	@Param		X-Actor-ID	header		string						false	"The user making the change."
+//
+//	@Success	200			{object}	GuildSettingsResponse
+//	@Failure	400			{object}	APIError
+//	@Failure	404			{object}	APIError
+//
+// nolint:staticcheck
+func (h *GuildHandler) UpdateAuditLogChannel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	guildId := chi.URLParam(r, "guildId")
+	var body *GuildAuditLogChannelBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: ErrInvalidRequestBody.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	if err := body.Validate(); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	settings, err := h.uc.UpdateAuditLogChannel(ctx, guildId, *body.ChannelID)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrAuditLogChannelNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusBadRequest)
+			case u.ErrGuildNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, GuildSettingsResponse{
+		Data: *settings,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/settings/activity-roles/resync [POST]
+//	@Tags		Guilds
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id	path		string	true	"The guild ID."
+//
+//	@Success	202			{object}	ActivityRoleResyncJobResponse
+//	@Failure	404			{object}	APIError
+//	@Failure	409			{object}	APIError
+//
+// nolint:staticcheck
+func (h *GuildHandler) EnqueueActivityRoleResync(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	guildId := chi.URLParam(r, "guildId")
+	job, err := h.uc.EnqueueActivityRoleResync(ctx, guildId)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrGuildNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			case u.ErrActivityRoleResyncJobExists.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusConflict)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, ActivityRoleResyncJobResponse{
+		Data: *job,
+	}, http.StatusAccepted)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/settings/activity-roles/resync/{job_id} [GET]
+//	@Tags		Guilds
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id	path		string	true	"The guild ID."
+//	@Param		job_id		path		int		true	"The resync job ID."
+//
+//	@Success	200			{object}	ActivityRoleResyncJobResponse
+//	@Failure	400			{object}	APIError
+//	@Failure	404			{object}	APIError
+//
+// nolint:staticcheck
+func (h *GuildHandler) GetActivityRoleResyncJob(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	guildId := chi.URLParam(r, "guildId")
+	jobId, err := strconv.Atoi(chi.URLParam(r, "jobId"))
+	if err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: ErrInvalidRequestBody.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	job, err := h.uc.GetActivityRoleResyncJob(ctx, guildId, int32(jobId))
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrActivityRoleResyncJobNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, ActivityRoleResyncJobResponse{
+		Data: *job,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/settings/activity/{activity_type}/deny-roles [GET]
+//	@Tags		Guilds
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id		path		string	true	"The guild ID."
+//	@Param		activity_type	path		string	true	"The activity type."	Enum(chat, voice)
+//
+//	@Success	200				{object}	GuildActivityDenyRolesResponse
+//	@Failure	400				{object}	APIError
+//	@Failure	404				{object}	APIError
+//
+// nolint:staticcheck
+func (h *GuildHandler) GetActivityDenyRoles(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	guildId := chi.URLParam(r, "guildId")
+	activityType := chi.URLParam(r, "activityType")
+
+	denyRoles, err := h.uc.GetActivityDenyRoles(ctx, guildId, activityType)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrInvalidActivityType.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusBadRequest)
+			case u.ErrGuildNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, GuildActivityDenyRolesResponse{
+		Data: denyRoles,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/settings/activity/{activity_type}/deny-roles [POST]
+//	@Tags		Guilds
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id		path		string						true	"The guild ID."
+//	@Param		activity_type	path		string						true	"The activity type."	Enum(chat, voice)
+//	@Param		body			body		GuildActivityDenyRoleBody	true	"The role to deny from earning activity points."
+//	@Param		X-Actor-ID		header		string						false	"The user making the change."
+//
+//	@Success	200				{object}	GuildActivityDenyRolesResponse
+//	@Failure	400				{object}	APIError
+//	@Failure	404				{object}	APIError
+//
+// nolint:staticcheck
+func (h *GuildHandler) AddActivityDenyRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	guildId := chi.URLParam(r, "guildId")
+	activityType := chi.URLParam(r, "activityType")
+
+	var body *GuildActivityDenyRoleBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: ErrInvalidRequestBody.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	if err := body.Validate(); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	denyRoles, err := h.uc.AddActivityDenyRole(ctx, guildId, activityType, body.RoleID)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrInvalidActivityType.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusBadRequest)
+			case u.ErrGuildNotFound.Code:
+				fallthrough
+			case u.ErrGuildRoleNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, GuildActivityDenyRolesResponse{
+		Data: denyRoles,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/settings/activity/{activity_type}/deny-roles/{role_id} [DELETE]
+//	@Tags		Guilds
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id		path		string	true	"The guild ID."
+//	@Param		activity_type	path		string	true	"The activity type."	Enum(chat, voice)
+//	@Param		role_id			path		string	true	"The role ID."
+//	@Param		X-Actor-ID		header		string	false	"The user making the change."
+//
+//	@Success	200				{object}	GuildActivityDenyRolesResponse
+//	@Failure	400				{object}	APIError
+//	@Failure	404				{object}	APIError
+//
+// nolint:staticcheck
+func (h *GuildHandler) RemoveActivityDenyRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	guildId := chi.URLParam(r, "guildId")
+	activityType := chi.URLParam(r, "activityType")
+	roleId := chi.URLParam(r, "roleId")
+
+	denyRoles, err := h.uc.RemoveActivityDenyRole(ctx, guildId, activityType, roleId)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrInvalidActivityType.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusBadRequest)
+			case u.ErrGuildNotFound.Code:
+				fallthrough
+			case u.ErrActivityDenyRoleNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, GuildActivityDenyRolesResponse{
+		Data: denyRoles,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/settings/activity/channel-multipliers [GET]
+//	@Tags		Guilds
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id	path		string	true	"The guild ID."
+//
+//	@Success	200			{object}	GuildActivityChannelMultipliersResponse
+//
+// nolint:staticcheck
+func (h *GuildHandler) GetChatActivityChannelMultipliers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	guildId := chi.URLParam(r, "guildId")
+	multipliers, err := h.uc.GetChatActivityChannelMultipliers(ctx, guildId)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, GuildActivityChannelMultipliersResponse{
+		Data: multipliers,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/settings/activity/channel-multipliers/{channel_id} [PUT]
+//	@Tags		Guilds
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id	path		string								true	"The guild ID."
+//	@Param		channel_id	path		string								true	"The channel or category ID."
+//	@Param		body		body		GuildActivityChannelMultiplierBody	true	"The multiplier, 0 excludes the channel."
+//	@Param		X-Actor-ID	header		string								false	"The user making the change."
+//
+//	@Success	200			{object}	GuildActivityChannelMultiplierResponse
+//	@Failure	400			{object}	APIError
+//	@Failure	404			{object}	APIError
+//
+// nolint:staticcheck
+func (h *GuildHandler) SetChatActivityChannelMultiplier(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	guildId := chi.URLParam(r, "guildId")
+	channelId := chi.URLParam(r, "channelId")
+
+	var body *GuildActivityChannelMultiplierBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: ErrInvalidRequestBody.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	if err := body.Validate(); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	multiplier, err := h.uc.SetChatActivityChannelMultiplier(ctx, guildId, channelId, *body.Multiplier)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrGuildNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, GuildActivityChannelMultiplierResponse{
+		Data: *multiplier,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/settings/activity/channel-multipliers/{channel_id} [DELETE]
+//	@Tags		Guilds
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id	path		string	true	"The guild ID."
+//	@Param		channel_id	path		string	true	"The channel or category ID."
+//	@Param		X-Actor-ID	header		string	false	"The user making the change."
+//
+//	@Failure	404			{object}	APIError
+//
+// nolint:staticcheck
+func (h *GuildHandler) DeleteChatActivityChannelMultiplier(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	guildId := chi.URLParam(r, "guildId")
+	channelId := chi.URLParam(r, "channelId")
+
+	err := h.uc.DeleteChatActivityChannelMultiplier(ctx, guildId, channelId)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrChannelMultiplierNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, APIResponse[any]{
+		Data: nil,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/settings/activity-boosts [GET]
+//	@Tags		Guilds
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id	path		string	true	"The guild ID."
+//
+//	@Success	200			{object}	ActivityBoostsResponse
+//
+// nolint:staticcheck
+func (h *GuildHandler) GetActivityBoosts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	guildId := chi.URLParam(r, "guildId")
+	boosts, err := h.uc.GetActivityBoosts(ctx, guildId)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
 
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
 			return
 		}
 
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	err = httpx.WriteJSON(w, GuildSettingsResponse{
-		Data: *settings,
+	err = httpx.WriteJSON(w, ActivityBoostsResponse{
+		Data: boosts,
 	}, http.StatusOK)
 	if err != nil {
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 	}
 }
 
-//	@Router		/v1/guild/{guild_id}/settings [GET]
+//	@Router		/v1/guild/{guild_id}/settings/activity-boosts [POST]
 //	@Tags		Guilds
 //
 //	@Security	APIKeyAuth
 //
-//	@Param		guild_id	path		string	true	"The guild ID."
+//	@Param		guild_id	path		string					true	"The guild ID."
+//	@Param		body		body		ActivityBoostCreateBody	true	"The activity boost to schedule."
+//	@Param		X-Actor-ID	header		string					false	"The user making the change."
 //
-//	@Success	200			{object}	GuildSettingsResponse
+//	@Success	201			{object}	ActivityBoostResponse
 //	@Failure	400			{object}	APIError
 //	@Failure	404			{object}	APIError
 //
 // nolint:staticcheck
-func (h *GuildHandler) GetGuildSettings(w http.ResponseWriter, r *http.Request) {
+func (h *GuildHandler) CreateActivityBoost(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	guildId := chi.URLParam(r, "guildId")
-	settings, err := h.uc.GetGuildSettings(ctx, guildId)
+	var body *ActivityBoostCreateBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: ErrInvalidRequestBody.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
 
+		return
+	}
+
+	if err := body.Validate(); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	boost, err := h.uc.CreateActivityBoost(ctx, guildId, u.CreateActivityBoostOpts(*body))
 	if err != nil {
 		if errors.Is(err, context.Canceled) {
 			return
@@ -140,9 +1614,18 @@ func (h *GuildHandler) GetGuildSettings(w http.ResponseWriter, r *http.Request)
 
 		var ueErr u.UsecaseError
 		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
 			var writeErr error
 
 			switch ueErr.Code {
+			case u.ErrInvalidActivityType.Code:
+				fallthrough
+			case u.ErrInvalidActivityBoost.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusBadRequest)
 			case u.ErrGuildNotFound.Code:
 				writeErr = httpx.WriteJSON(w, APIError{
 					Code:    ueErr.Code,
@@ -151,73 +1634,58 @@ func (h *GuildHandler) GetGuildSettings(w http.ResponseWriter, r *http.Request)
 			}
 
 			if writeErr != nil {
-				log.Error(writeErr)
+				log.WithContext(r.Context()).Error(writeErr)
 				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 			}
 
 			return
 		}
 
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	err = httpx.WriteJSON(w, GuildSettingsResponse{
-		Data: *settings,
-	}, http.StatusOK)
+	err = httpx.WriteJSON(w, ActivityBoostResponse{
+		Data: *boost,
+	}, http.StatusCreated)
 	if err != nil {
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 	}
 }
 
-//	@Router		/v1/guild/{guild_id}/settings/activity  [PATCH]
+//	@Router		/v1/guild/{guild_id}/settings/activity-boosts/{boost_id} [GET]
 //	@Tags		Guilds
 //
 //	@Security	APIKeyAuth
 //
-//	@Param		guild_id	path		string							true	"The guild ID."
-//	@Param		settings	body		GuildActivitySettingsUpdateBody	true	"The activity settings."
+//	@Param		guild_id	path		string	true	"The guild ID."
+//	@Param		boost_id	path		int		true	"The activity boost ID."
 //
-//	@Success	200			{object}	GuildSettingsResponse
+//	@Success	200			{object}	ActivityBoostResponse
 //	@Failure	400			{object}	APIError
 //	@Failure	404			{object}	APIError
 //
 // nolint:staticcheck
-func (h *GuildHandler) UpdateGuildActivitySettings(w http.ResponseWriter, r *http.Request) {
+func (h *GuildHandler) GetActivityBoost(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	guildId := chi.URLParam(r, "guildId")
-	var updateBody *GuildActivitySettingsUpdateBody
-	if err := json.NewDecoder(r.Body).Decode(&updateBody); err != nil {
+	boostId, err := strconv.Atoi(chi.URLParam(r, "boostId"))
+	if err != nil {
 		err := httpx.WriteJSON(w, APIError{
 			Message: ErrInvalidRequestBody.Error(),
 		}, http.StatusBadRequest)
 
 		if err != nil {
-			log.Error(err)
-			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
-		}
-
-		return
-	}
-	if err := updateBody.Validate(); err != nil {
-		err := httpx.WriteJSON(w, APIError{
-			Message: err.Error(),
-		}, http.StatusBadRequest)
-
-		if err != nil {
-			log.Error(err)
+			log.WithContext(r.Context()).Error(err)
 			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
 		}
 
 		return
 	}
 
-	settings, err := h.uc.UpdateGuildActivitySettings(ctx, guildId, u.UpdateAcitivtySettings{
-		ChatActivity: updateBody.ChatActivity,
-	})
-
+	boost, err := h.uc.GetActivityBoost(ctx, guildId, int32(boostId))
 	if err != nil {
 		if errors.Is(err, context.Canceled) {
 			return
@@ -230,10 +1698,12 @@ func (h *GuildHandler) UpdateGuildActivitySettings(w http.ResponseWriter, r *htt
 
 		var ueErr u.UsecaseError
 		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
 			var writeErr error
 
 			switch ueErr.Code {
-			case u.ErrGuildNotFound.Code:
+			case u.ErrActivityBoostNotFound.Code:
 				writeErr = httpx.WriteJSON(w, APIError{
 					Code:    ueErr.Code,
 					Message: ueErr.Message,
@@ -241,58 +1711,87 @@ func (h *GuildHandler) UpdateGuildActivitySettings(w http.ResponseWriter, r *htt
 			}
 
 			if writeErr != nil {
-				log.Error(writeErr)
+				log.WithContext(r.Context()).Error(writeErr)
 				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 			}
 
 			return
 		}
 
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	err = httpx.WriteJSON(w, GuildSettingsResponse{
-		Data: *settings,
+	err = httpx.WriteJSON(w, ActivityBoostResponse{
+		Data: *boost,
 	}, http.StatusOK)
 	if err != nil {
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 	}
 }
 
-//	@Router		/v1/guild/{guild_id}/settings/activity-roles [POST]
+//	@Router		/v1/guild/{guild_id}/settings/activity-boosts/{boost_id} [PATCH]
 //	@Tags		Guilds
 //
 //	@Security	APIKeyAuth
 //
-//	@Param		guild_id	path		string						true	"The guild ID."
-//	@Param		role		body		GuildActivityRoleCreateBody	true	"The activity settings."
+//	@Param		guild_id	path		string					true	"The guild ID."
+//	@Param		boost_id	path		int						true	"The activity boost ID."
+//	@Param		body		body		ActivityBoostUpdateBody	true	"The activity boost changes."
+//	@Param		X-Actor-ID	header		string					false	"The user making the change."
 //
-//	@Success	200			{object}	GuildSettingsResponse
+//	@Success	200			{object}	ActivityBoostResponse
 //	@Failure	400			{object}	APIError
 //	@Failure	404			{object}	APIError
 //
 // nolint:staticcheck
-func (h *GuildHandler) CreateActivityRole(w http.ResponseWriter, r *http.Request) {
+func (h *GuildHandler) UpdateActivityBoost(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	guildId := chi.URLParam(r, "guildId")
-	var createBody *GuildActivityRoleCreateBody
-	if err := json.NewDecoder(r.Body).Decode(&createBody); err != nil {
+	boostId, err := strconv.Atoi(chi.URLParam(r, "boostId"))
+	if err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: ErrInvalidRequestBody.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	var body *ActivityBoostUpdateBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		err := httpx.WriteJSON(w, APIError{
 			Message: ErrInvalidRequestBody.Error(),
 		}, http.StatusBadRequest)
 
 		if err != nil {
-			log.Error(err)
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	if err := body.Validate(); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
 			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
 		}
 
 		return
 	}
 
-	_, err := h.uc.CreateActivityRole(ctx, guildId, createBody.ActivityType, createBody.RoleID, createBody.RequiredPoints)
+	boost, err := h.uc.UpdateActivityBoost(ctx, guildId, int32(boostId), u.UpdateActivityBoostOpts(*body))
 	if err != nil {
 		if errors.Is(err, context.Canceled) {
 			return
@@ -305,86 +1804,76 @@ func (h *GuildHandler) CreateActivityRole(w http.ResponseWriter, r *http.Request
 
 		var ueErr u.UsecaseError
 		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
 			var writeErr error
 
 			switch ueErr.Code {
-			case u.ErrActivityRoleExists.Code:
+			case u.ErrInvalidActivityBoost.Code:
 				writeErr = httpx.WriteJSON(w, APIError{
 					Code:    ueErr.Code,
 					Message: ueErr.Message,
-				}, http.StatusConflict)
+				}, http.StatusBadRequest)
+			case u.ErrActivityBoostNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
 			}
 
 			if writeErr != nil {
-				log.Error(writeErr)
+				log.WithContext(r.Context()).Error(writeErr)
 				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 			}
 
 			return
 		}
 
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	err = httpx.WriteJSON(w, APIResponse[any]{
-		Data: nil,
-	}, http.StatusCreated)
+	err = httpx.WriteJSON(w, ActivityBoostResponse{
+		Data: *boost,
+	}, http.StatusOK)
 	if err != nil {
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 	}
 }
 
-//	@Router		/v1/guild/{guild_id}/settings/message-embeds [POST]
+//	@Router		/v1/guild/{guild_id}/settings/activity-boosts/{boost_id} [DELETE]
 //	@Tags		Guilds
 //
 //	@Security	APIKeyAuth
 //
-//	@Param		guild_id	path	string	true	"The guild ID."
+//	@Param		guild_id	path		string	true	"The guild ID."
+//	@Param		boost_id	path		int		true	"The activity boost ID."
+//	@Param		X-Actor-ID	header		string	false	"The user making the change."
+//
+//	@Failure	400			{object}	APIError
+//	@Failure	404			{object}	APIError
 //
 // nolint:staticcheck
-func (h *GuildHandler) UpdateGuildMessageEmbedSettings(w http.ResponseWriter, r *http.Request) {
+func (h *GuildHandler) DeleteActivityBoost(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	guildId := chi.URLParam(r, "guildId")
-	var body *GuildMessageEmbedSettingsUpdateBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+	boostId, err := strconv.Atoi(chi.URLParam(r, "boostId"))
+	if err != nil {
 		err := httpx.WriteJSON(w, APIError{
 			Message: ErrInvalidRequestBody.Error(),
 		}, http.StatusBadRequest)
 
 		if err != nil {
-			log.Error(err)
-			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
-		}
-
-		return
-	}
-
-	if err := body.Validate(); err != nil {
-		err := httpx.WriteJSON(w, APIError{
-			Message: err.Error(),
-		}, http.StatusBadRequest)
-
-		if err != nil {
-			log.Error(err)
+			log.WithContext(r.Context()).Error(err)
 			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
 		}
 
 		return
 	}
 
-	settings, err := h.uc.UpdateMessageEmbedSettings(ctx, guildId, u.UpdateMessageEmbedSettingsOpts{
-		IsEnabled:             body.IsEnabled,
-		AddDisabledChannel:    body.AddDisabledChannel,
-		RemoveDisabledChannel: body.RemoveDisabledChannel,
-		AddIgnoredChannel:     body.AddIgnoredChannel,
-		RemoveIgnoredChannel:  body.RemoveIgnoredChannel,
-		AddIgnoredRole:        body.AddIgnoredRole,
-		RemoveIgnoredRole:     body.RemoveIgnoredRole,
-	})
-
+	err = h.uc.DeleteActivityBoost(ctx, guildId, int32(boostId))
 	if err != nil {
 		if errors.Is(err, context.Canceled) {
 			return
@@ -397,10 +1886,12 @@ func (h *GuildHandler) UpdateGuildMessageEmbedSettings(w http.ResponseWriter, r
 
 		var ueErr u.UsecaseError
 		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
 			var writeErr error
 
 			switch ueErr.Code {
-			case u.ErrGuildNotFound.Code:
+			case u.ErrActivityBoostNotFound.Code:
 				writeErr = httpx.WriteJSON(w, APIError{
 					Code:    ueErr.Code,
 					Message: ueErr.Message,
@@ -408,23 +1899,23 @@ func (h *GuildHandler) UpdateGuildMessageEmbedSettings(w http.ResponseWriter, r
 			}
 
 			if writeErr != nil {
-				log.Error(writeErr)
+				log.WithContext(r.Context()).Error(writeErr)
 				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 			}
 
 			return
 		}
 
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	err = httpx.WriteJSON(w, GuildSettingsResponse{
-		Data: *settings,
+	err = httpx.WriteJSON(w, APIResponse[any]{
+		Data: nil,
 	}, http.StatusOK)
 	if err != nil {
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 	}
 }
 
@@ -436,8 +1927,14 @@ func (h *GuildHandler) UpdateGuildMessageEmbedSettings(w http.ResponseWriter, r
 //	@Security	APIKeyAuth
 //
 //	@Param		guild_id		path	string	true	"The guild ID."
-//	@Param		activity_type	query	string	true	"The activity type."	Enum(chat, voice)
-//	@Param		time_period		query	string	true	"The time period."		Enum(weekly, monthly, all)
+//	@Param		activity_type	query	string	true	"The activity type."							Enum(chat, voice)
+//	@Param		time_period		query	string	true	"The time period."								Enum(weekly, monthly, all)
+//	@Param		format			query	string	false	"Renders the card to an image instead of HTML."	Enum(html, png, webp)
+//	@Param		If-None-Match	header	string	false	"The ETag of a previously fetched card, a 304 is returned when it hasn't changed."
+//
+//	@Produce	html
+//	@Produce	png
+//	@Produce	image/webp
 //
 // nolint:staticcheck
 func (h *GuildHandler) GenerateGuildActivityLeaderboardCard(w http.ResponseWriter, r *http.Request) {
@@ -446,19 +1943,53 @@ func (h *GuildHandler) GenerateGuildActivityLeaderboardCard(w http.ResponseWrite
 	guildId := chi.URLParam(r, "guildId")
 	activityType := httpx.GetQueryParam(r, "activity_type", "chat")
 	timePeriod := httpx.GetQueryParam(r, "time_period", "all")
+	format := httpx.GetQueryParam(r, "format", "html")
 
-	card, err := h.uc.GenerateGuildActivityLeaderboardCard(ctx, guildId, activityType, timePeriod, 1)
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+	etag, err := h.uc.GetGuildActivityLeaderboardCardETag(ctx, guildId, activityType, timePeriod, 1, format)
+	var card *u.RenderedCard
+	if err == nil {
+		if cardNotModified(w, r, etag) {
+			return
+		}
+
+		card, err = h.uc.GenerateGuildActivityLeaderboardCard(ctx, guildId, activityType, timePeriod, 1, format)
 	}
 
-	w.WriteHeader(http.StatusOK)
-	w.Header().Set("Content-Type", "text/html; charset=utf-8")
-	if err := card.Render(w); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			status := http.StatusInternalServerError
+			switch ueErr.Code {
+			case u.ErrInvalidCardFormat.Code, u.ErrUnsupportedCardFormat.Code:
+				status = http.StatusBadRequest
+			case u.ErrCardRenderingDisabled.Code:
+				status = http.StatusNotImplemented
+			}
+
+			writeErr := httpx.WriteJSON(w, APIError{
+				Code:    ueErr.Code,
+				Message: ueErr.Message,
+			}, status)
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 		return
 	}
+
+	writeCard(w, r, card, etag)
 }
 
 //	@Router		/v2/guild/{guild_id}/activity-leaderboard-card [GET]
@@ -469,6 +2000,8 @@ func (h *GuildHandler) GenerateGuildActivityLeaderboardCard(w http.ResponseWrite
 //	@Param		guild_id		path	string	true	"The guild ID."
 //	@Param		activity_type	query	string	true	"The activity type."	Enum(chat, voice)
 //	@Param		time_period		query	string	true	"The time period."		Enum(weekly, monthly, all)
+//	@Param		period_start	query	string	false	"The start of an archived weekly or monthly period, or previous for the last period."
+//	@Param		If-None-Match	header	string	false	"The ETag of a previously fetched card, a 304 is returned when it hasn't changed."
 //
 // nolint:staticcheck
 func (h *GuildHandler) GetGuildActivityLeaderboard(w http.ResponseWriter, r *http.Request) {
@@ -481,7 +2014,7 @@ func (h *GuildHandler) GetGuildActivityLeaderboard(w http.ResponseWriter, r *htt
 		}, http.StatusBadRequest)
 
 		if err != nil {
-			log.Error(err)
+			log.WithContext(r.Context()).Error(err)
 			http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 		}
 
@@ -491,13 +2024,23 @@ func (h *GuildHandler) GetGuildActivityLeaderboard(w http.ResponseWriter, r *htt
 	guildId := chi.URLParam(r, "guildId")
 	activityType := httpx.GetQueryParam(r, "activity_type", "chat")
 	timePeriod := httpx.GetQueryParam(r, "time_period", "all")
+	periodStart := httpx.GetQueryParam(r, "period_start", "")
 	pageStr := httpx.GetQueryParam(r, "page", "1")
 	page, err := strconv.Atoi(pageStr)
 	if err != nil {
 		page = 1
 	}
 
-	leaderboard, err := h.uc.GetGuildActivityLeaderboard(ctx, referer, guildId, activityType, timePeriod, page)
+	etag, err := h.uc.GetGuildActivityLeaderboardETag(ctx, referer, guildId, activityType, timePeriod, periodStart, page)
+	var leaderboard *u.GuildLeaderboard
+	if err == nil {
+		if cardNotModified(w, r, etag) {
+			return
+		}
+
+		leaderboard, err = h.uc.GetGuildActivityLeaderboard(ctx, referer, guildId, activityType, timePeriod, periodStart, page)
+	}
+
 	if err != nil {
 		if errors.Is(err, context.Canceled) {
 			return
@@ -510,6 +2053,8 @@ func (h *GuildHandler) GetGuildActivityLeaderboard(w http.ResponseWriter, r *htt
 
 		var ueErr u.UsecaseError
 		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
 			var writeErr error
 
 			switch ueErr.Code {
@@ -520,26 +2065,100 @@ func (h *GuildHandler) GetGuildActivityLeaderboard(w http.ResponseWriter, r *htt
 					Code:    ueErr.Code,
 					Message: ueErr.Message,
 				}, http.StatusNotFound)
+			case u.ErrInvalidLeaderboardTimeframe.Code:
+				fallthrough
+			case u.ErrInvalidLeaderboardPeriod.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusBadRequest)
 			}
 
 			if writeErr != nil {
-				log.Error(writeErr)
+				log.WithContext(r.Context()).Error(writeErr)
 				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 			}
 
 			return
 		}
 
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 		return
 	}
 
+	setCardETag(w, etag)
 	err = httpx.WriteJSON(w, APIResponse[u.GuildLeaderboard]{
 		Data: *leaderboard,
 	}, http.StatusOK)
 	if err != nil {
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/activity-leaderboard/periods [GET]
+//	@Tags		Guilds
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id		path		string	true	"The guild ID."
+//	@Param		activity_type	query		string	false	"The activity type."	Enums(chat, voice)
+//	@Param		time_period		query		string	true	"The time period."		Enums(weekly, monthly)
+//
+//	@Success	200				{object}	ArchivedLeaderboardPeriodsResponse
+//	@Failure	400				{object}	APIError
+//
+// nolint:staticcheck
+func (h *GuildHandler) GetArchivedLeaderboardPeriods(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	guildId := chi.URLParam(r, "guildId")
+	activityType := httpx.GetQueryParam(r, "activity_type", "chat")
+	timePeriod := httpx.GetQueryParam(r, "time_period", "")
+
+	periods, err := h.uc.GetArchivedLeaderboardPeriods(ctx, guildId, activityType, timePeriod)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrInvalidLeaderboardTimeframe.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusBadRequest)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, ArchivedLeaderboardPeriodsResponse{
+		Data: periods,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
 	}
 }
 
@@ -550,6 +2169,7 @@ func (h *GuildHandler) GetGuildActivityLeaderboard(w http.ResponseWriter, r *htt
 //
 //	@Param		guild_id			path	string	true	"The guild ID."
 //	@Param		origin_channel_id	path	string	true	"The channel ID for the lobby origin."
+//	@Param		X-Actor-ID			header	string	false	"The user making the change."
 //
 // nolint:staticcheck
 func (h *GuildHandler) CreateVoiceRoomLobby(w http.ResponseWriter, r *http.Request) {
@@ -564,7 +2184,7 @@ func (h *GuildHandler) CreateVoiceRoomLobby(w http.ResponseWriter, r *http.Reque
 		}, http.StatusBadRequest)
 
 		if err != nil {
-			log.Error(err)
+			log.WithContext(r.Context()).Error(err)
 			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
 		}
 
@@ -589,6 +2209,8 @@ func (h *GuildHandler) CreateVoiceRoomLobby(w http.ResponseWriter, r *http.Reque
 
 		var ueErr u.UsecaseError
 		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
 			var writeErr error
 
 			switch ueErr.Code {
@@ -602,14 +2224,14 @@ func (h *GuildHandler) CreateVoiceRoomLobby(w http.ResponseWriter, r *http.Reque
 			}
 
 			if writeErr != nil {
-				log.Error(writeErr)
+				log.WithContext(r.Context()).Error(writeErr)
 				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 			}
 
 			return
 		}
 
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -618,7 +2240,7 @@ func (h *GuildHandler) CreateVoiceRoomLobby(w http.ResponseWriter, r *http.Reque
 		Data: *lobby,
 	}, http.StatusCreated)
 	if err != nil {
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 	}
 }
 
@@ -650,6 +2272,8 @@ func (h *GuildHandler) GetVoiceRoomLobby(w http.ResponseWriter, r *http.Request)
 
 		var ueErr u.UsecaseError
 		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
 			var writeErr error
 
 			switch ueErr.Code {
@@ -661,13 +2285,13 @@ func (h *GuildHandler) GetVoiceRoomLobby(w http.ResponseWriter, r *http.Request)
 			}
 
 			if writeErr != nil {
-				log.Error(writeErr)
+				log.WithContext(r.Context()).Error(writeErr)
 				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 			}
 
 			return
 		}
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -676,7 +2300,7 @@ func (h *GuildHandler) GetVoiceRoomLobby(w http.ResponseWriter, r *http.Request)
 		Data: *lobby,
 	}, http.StatusOK)
 	if err != nil {
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 	}
 }
 
@@ -687,6 +2311,7 @@ func (h *GuildHandler) GetVoiceRoomLobby(w http.ResponseWriter, r *http.Request)
 //
 //	@Param		guild_id			path	string	true	"The guild ID."
 //	@Param		origin_channel_id	path	string	true	"The channel ID for the lobby origin."
+//	@Param		X-Actor-ID			header	string	false	"The user making the change."
 //
 // nolint:staticcheck
 func (h *GuildHandler) UpdateVoiceRoomLobby(w http.ResponseWriter, r *http.Request) {
@@ -701,7 +2326,7 @@ func (h *GuildHandler) UpdateVoiceRoomLobby(w http.ResponseWriter, r *http.Reque
 		}, http.StatusBadRequest)
 
 		if err != nil {
-			log.Error(err)
+			log.WithContext(r.Context()).Error(err)
 			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
 		}
 
@@ -727,6 +2352,8 @@ func (h *GuildHandler) UpdateVoiceRoomLobby(w http.ResponseWriter, r *http.Reque
 
 		var ueErr u.UsecaseError
 		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
 			var writeErr error
 
 			switch ueErr.Code {
@@ -738,14 +2365,14 @@ func (h *GuildHandler) UpdateVoiceRoomLobby(w http.ResponseWriter, r *http.Reque
 			}
 
 			if writeErr != nil {
-				log.Error(writeErr)
+				log.WithContext(r.Context()).Error(writeErr)
 				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 			}
 
 			return
 		}
 
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -754,7 +2381,7 @@ func (h *GuildHandler) UpdateVoiceRoomLobby(w http.ResponseWriter, r *http.Reque
 		Data: *lobby,
 	}, http.StatusOK)
 	if err != nil {
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 	}
 }
 
@@ -765,6 +2392,7 @@ func (h *GuildHandler) UpdateVoiceRoomLobby(w http.ResponseWriter, r *http.Reque
 //
 //	@Param		guild_id			path	string	true	"The guild ID."
 //	@Param		origin_channel_id	path	string	true	"The channel ID for the lobby origin."
+//	@Param		X-Actor-ID			header	string	false	"The user making the change."
 //
 // nolint:staticcheck
 func (h *GuildHandler) DeleteVoiceRoomLobby(w http.ResponseWriter, r *http.Request) {
@@ -786,6 +2414,8 @@ func (h *GuildHandler) DeleteVoiceRoomLobby(w http.ResponseWriter, r *http.Reque
 
 		var ueErr u.UsecaseError
 		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
 			var writeErr error
 
 			switch ueErr.Code {
@@ -797,14 +2427,14 @@ func (h *GuildHandler) DeleteVoiceRoomLobby(w http.ResponseWriter, r *http.Reque
 			}
 
 			if writeErr != nil {
-				log.Error(writeErr)
+				log.WithContext(r.Context()).Error(writeErr)
 				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 			}
 
 			return
 		}
 
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -813,7 +2443,7 @@ func (h *GuildHandler) DeleteVoiceRoomLobby(w http.ResponseWriter, r *http.Reque
 		Data: nil,
 	}, http.StatusOK)
 	if err != nil {
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 	}
 }
 
@@ -838,7 +2468,7 @@ func (h *GuildHandler) RegisterVoiceRoom(w http.ResponseWriter, r *http.Request)
 		}, http.StatusBadRequest)
 
 		if err != nil {
-			log.Error(err)
+			log.WithContext(r.Context()).Error(err)
 			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
 		}
 
@@ -858,6 +2488,8 @@ func (h *GuildHandler) RegisterVoiceRoom(w http.ResponseWriter, r *http.Request)
 
 		var ueErr u.UsecaseError
 		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
 			var writeErr error
 
 			switch ueErr.Code {
@@ -869,14 +2501,14 @@ func (h *GuildHandler) RegisterVoiceRoom(w http.ResponseWriter, r *http.Request)
 			}
 
 			if writeErr != nil {
-				log.Error(writeErr)
+				log.WithContext(r.Context()).Error(writeErr)
 				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 			}
 
 			return
 		}
 
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -885,7 +2517,7 @@ func (h *GuildHandler) RegisterVoiceRoom(w http.ResponseWriter, r *http.Request)
 		Data: *registeredRoom,
 	}, http.StatusOK)
 	if err != nil {
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 	}
 }
 
@@ -917,6 +2549,8 @@ func (h *GuildHandler) GetVoiceRoom(w http.ResponseWriter, r *http.Request) {
 
 		var ueErr u.UsecaseError
 		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
 			var writeErr error
 
 			switch ueErr.Code {
@@ -928,14 +2562,14 @@ func (h *GuildHandler) GetVoiceRoom(w http.ResponseWriter, r *http.Request) {
 			}
 
 			if writeErr != nil {
-				log.Error(writeErr)
+				log.WithContext(r.Context()).Error(writeErr)
 				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 			}
 
 			return
 		}
 
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -944,7 +2578,7 @@ func (h *GuildHandler) GetVoiceRoom(w http.ResponseWriter, r *http.Request) {
 		Data: *room,
 	}, http.StatusOK)
 	if err != nil {
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 	}
 }
 
@@ -969,7 +2603,7 @@ func (h *GuildHandler) UpdateVoiceRoom(w http.ResponseWriter, r *http.Request) {
 		}, http.StatusBadRequest)
 
 		if err != nil {
-			log.Error(err)
+			log.WithContext(r.Context()).Error(err)
 			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
 		}
 
@@ -993,6 +2627,8 @@ func (h *GuildHandler) UpdateVoiceRoom(w http.ResponseWriter, r *http.Request) {
 
 		var ueErr u.UsecaseError
 		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
 			var writeErr error
 
 			switch ueErr.Code {
@@ -1004,14 +2640,14 @@ func (h *GuildHandler) UpdateVoiceRoom(w http.ResponseWriter, r *http.Request) {
 			}
 
 			if writeErr != nil {
-				log.Error(writeErr)
+				log.WithContext(r.Context()).Error(writeErr)
 				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 			}
 
 			return
 		}
 
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -1020,7 +2656,7 @@ func (h *GuildHandler) UpdateVoiceRoom(w http.ResponseWriter, r *http.Request) {
 		Data: *room,
 	}, http.StatusOK)
 	if err != nil {
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 	}
 }
 
@@ -1052,6 +2688,8 @@ func (h *GuildHandler) DeleteVoiceRoom(w http.ResponseWriter, r *http.Request) {
 
 		var ueErr u.UsecaseError
 		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
 			var writeErr error
 
 			switch ueErr.Code {
@@ -1063,14 +2701,14 @@ func (h *GuildHandler) DeleteVoiceRoom(w http.ResponseWriter, r *http.Request) {
 			}
 
 			if writeErr != nil {
-				log.Error(writeErr)
+				log.WithContext(r.Context()).Error(writeErr)
 				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 			}
 
 			return
 		}
 
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -1079,6 +2717,6 @@ func (h *GuildHandler) DeleteVoiceRoom(w http.ResponseWriter, r *http.Request) {
 		Data: nil,
 	}, http.StatusOK)
 	if err != nil {
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 	}
 }
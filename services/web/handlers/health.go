@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-chi/chi"
+	log "github.com/sirupsen/logrus"
+	"github.com/typical-developers/discord-bot-backend/pkg/httpx"
+)
+
+// How long every readiness check has to respond.
+const readinessTimeout = time.Second * 5
+
+// ReadinessCheck is a dependency that has to be available for the service to receive traffic.
+type ReadinessCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+type HealthHandler struct {
+	checks []ReadinessCheck
+
+	shuttingDown atomic.Bool
+}
+
+// NewHealthHandler registers the liveness and readiness endpoints.
+// These routes need to be public, since orchestrators don't send an API key.
+func NewHealthHandler(r *chi.Mux, checks ...ReadinessCheck) *HealthHandler {
+	h := &HealthHandler{checks: checks}
+
+	r.Get("/healthz", h.Liveness)
+	r.Get("/readyz", h.Readiness)
+
+	return h
+}
+
+// ShuttingDown makes the readiness endpoint fail, so traffic stops being routed to the service before it stops.
+func (h *HealthHandler) ShuttingDown() {
+	h.shuttingDown.Store(true)
+}
+
+//	@Router		/healthz [GET]
+//	@Tags		Health
+//
+//	@Success	200	{object}	HealthResponse
+//
+// nolint:staticcheck
+func (h *HealthHandler) Liveness(w http.ResponseWriter, r *http.Request) {
+	err := httpx.WriteJSON(w, HealthResponse{
+		Data: HealthStatus{Status: "ok"},
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/readyz [GET]
+//	@Tags		Health
+//
+//	@Success	200	{object}	HealthResponse
+//	@Failure	503	{object}	HealthResponse
+//
+// nolint:staticcheck
+func (h *HealthHandler) Readiness(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+	defer cancel()
+
+	status := HealthStatus{
+		Status: "ok",
+		Checks: make(map[string]string),
+	}
+
+	if h.shuttingDown.Load() {
+		status.Status = "shutting_down"
+	}
+
+	for _, check := range h.checks {
+		if err := check.Check(ctx); err != nil {
+			log.WithContext(ctx).WithError(err).WithField("check", check.Name).Warn("Readiness check failed.")
+
+			status.Status = "unavailable"
+			status.Checks[check.Name] = err.Error()
+			continue
+		}
+
+		status.Checks[check.Name] = "ok"
+	}
+
+	code := http.StatusOK
+	if status.Status != "ok" {
+		code = http.StatusServiceUnavailable
+	}
+
+	err := httpx.WriteJSON(w, HealthResponse{
+		Data: status,
+	}, code)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/go-chi/chi"
 	log "github.com/sirupsen/logrus"
@@ -16,23 +17,59 @@ type MemberHandler struct {
 	uc u.MemberUsecase
 }
 
-func NewMemberHandler(r *chi.Mux, uc u.MemberUsecase) {
+func NewMemberHandler(r *chi.Mux, uc u.MemberUsecase, rl *RateLimiter) {
 	h := MemberHandler{uc: uc}
 
+	limitDefault := rl.Group(RateLimitDefault)
+	limitActivityGrants := rl.Group(RateLimitActivityGrants)
+	limitCards := rl.Group(RateLimitCards)
+
 	r.Route("/v1/guild/{guildId}/member/{memberId}", func(r chi.Router) {
-		r.Post("/", h.CreateMemberProfile)
-		r.Get("/", h.GetMemberProfile)
-		r.Get("/profile-card", h.GenerateMemberProfileCard)
-		r.Patch("/chat-activity", h.IncrementMemberChatActivityPoints)
-		r.Post("/migrate", h.MigrateMemberProfile)
+		r.With(requireActivityGrant, limitDefault).Post("/", h.CreateMemberProfile)
+		r.With(requireRead, limitDefault).Get("/", h.GetMemberProfile)
+		r.With(requireRead, limitDefault).Get("/activity-history", h.GetMemberActivityHistory)
+		r.With(requireRead, limitDefault).Get("/activity-ledger", h.GetMemberActivityLedger)
+		r.With(requireHTML, limitCards).Get("/profile-card", h.GenerateMemberProfileCard)
+		r.With(requireActivityGrant, limitActivityGrants).Patch("/chat-activity", h.IncrementMemberChatActivityPoints)
+		r.With(requireActivityGrant, limitActivityGrants).Patch("/voice-activity", h.IncrementMemberVoiceActivityPoints)
+		r.With(requireSettingsWrite, limitDefault).Post("/migrate", h.MigrateMemberProfile)
+
+		r.With(requireRead, limitDefault).Get("/voice-session", h.GetMemberVoiceSession)
+		r.With(requireActivityGrant, limitActivityGrants).Post("/voice-session", h.OpenMemberVoiceSession)
+		r.With(requireActivityGrant, limitActivityGrants).Patch("/voice-session", h.UpdateMemberVoiceSession)
+		r.With(requireActivityGrant, limitActivityGrants).Delete("/voice-session", h.CloseMemberVoiceSession)
 	})
+
+	r.With(requireAdmin, limitDefault).Post("/v1/guild/{guildId}/activity-adjustments", h.AdjustMemberActivityPoints)
+}
+
+// writeCooldownError writes the member's remaining cooldown, which is also set as the Retry-After header.
+func writeCooldownError(w http.ResponseWriter, err error, ueErr u.UsecaseError) error {
+	var cooldownErr u.CooldownError
+	if !errors.As(err, &cooldownErr) {
+		return httpx.WriteJSON(w, APIError{
+			Code:    ueErr.Code,
+			Message: ueErr.Message,
+		}, http.StatusTooManyRequests)
+	}
+
+	w.Header().Set("Retry-After", strconv.Itoa(int(cooldownErr.RemainingSeconds)))
+	return httpx.WriteJSON(w, APICooldownError{
+		APIError: APIError{
+			Code:    ueErr.Code,
+			Message: ueErr.Message,
+		},
+		RemainingSeconds: cooldownErr.RemainingSeconds,
+	}, http.StatusTooManyRequests)
 }
 
-//	@Router	/v1/guild/{guild_id}/member/{member_id} [POST]
-//	@Tags	Members
+//	@Router		/v1/guild/{guild_id}/member/{member_id} [POST]
+//	@Tags		Members
+//
+//	@Security	APIKeyAuth
 //
-//	@Param	guild_id	path	string	true	"The guild ID."
-//	@Param	member_id	path	string	true	"The member ID."
+//	@Param		guild_id	path	string	true	"The guild ID."
+//	@Param		member_id	path	string	true	"The member ID."
 //
 // nolint:staticcheck
 func (h *MemberHandler) CreateMemberProfile(w http.ResponseWriter, r *http.Request) {
@@ -53,6 +90,8 @@ func (h *MemberHandler) CreateMemberProfile(w http.ResponseWriter, r *http.Reque
 
 		var ueErr u.UsecaseError
 		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
 			var writeErr error
 
 			switch ueErr.Code {
@@ -69,14 +108,14 @@ func (h *MemberHandler) CreateMemberProfile(w http.ResponseWriter, r *http.Reque
 			}
 
 			if writeErr != nil {
-				log.Error(writeErr)
+				log.WithContext(r.Context()).Error(writeErr)
 				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 			}
 
 			return
 		}
 
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -85,15 +124,17 @@ func (h *MemberHandler) CreateMemberProfile(w http.ResponseWriter, r *http.Reque
 		Data: *profile,
 	}, http.StatusOK)
 	if err != nil {
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 	}
 }
 
-//	@Router	/v1/guild/{guild_id}/member/{member_id} [GET]
-//	@Tags	Members
+//	@Router		/v1/guild/{guild_id}/member/{member_id} [GET]
+//	@Tags		Members
+//
+//	@Security	APIKeyAuth
 //
-//	@Param	guild_id	path	string	true	"The guild ID."
-//	@Param	member_id	path	string	true	"The member ID."
+//	@Param		guild_id	path	string	true	"The guild ID."
+//	@Param		member_id	path	string	true	"The member ID."
 //
 // nolint:staticcheck
 func (h *MemberHandler) GetMemberProfile(w http.ResponseWriter, r *http.Request) {
@@ -114,6 +155,8 @@ func (h *MemberHandler) GetMemberProfile(w http.ResponseWriter, r *http.Request)
 
 		var ueErr u.UsecaseError
 		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
 			var writeErr error
 
 			switch ueErr.Code {
@@ -130,14 +173,14 @@ func (h *MemberHandler) GetMemberProfile(w http.ResponseWriter, r *http.Request)
 			}
 
 			if writeErr != nil {
-				log.Error(writeErr)
+				log.WithContext(r.Context()).Error(writeErr)
 				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 			}
 
 			return
 		}
 
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -146,23 +189,235 @@ func (h *MemberHandler) GetMemberProfile(w http.ResponseWriter, r *http.Request)
 		Data: *profile,
 	}, http.StatusOK)
 	if err != nil {
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+// The maximum amount of weeks and months that can be requested for a member's activity history.
+const (
+	maxActivityHistoryWeeks  = 52
+	maxActivityHistoryMonths = 24
+)
+
+// createProfileParam parses the create_profile query parameter.
+// When it's not set, the guild's auto_create_profiles setting is used instead.
+func createProfileParam(r *http.Request) (*bool, bool) {
+	value := httpx.GetQueryParam(r, "create_profile")
+	if value == "" {
+		return nil, true
+	}
+
+	createProfile, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, false
+	}
+
+	return &createProfile, true
+}
+
+// writeInvalidQueryParam writes the response for a query parameter that couldn't be parsed.
+func writeInvalidQueryParam(w http.ResponseWriter, r *http.Request) {
+	err := httpx.WriteJSON(w, APIError{
+		Message: ErrInvalidRequestBody.Error(),
+	}, http.StatusBadRequest)
+
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+	}
+}
+
+// historyPeriodsParam parses the amount of periods to include in a member's activity history.
+func historyPeriodsParam(r *http.Request, key string, max int) (int, bool) {
+	periods, err := strconv.Atoi(httpx.GetQueryParam(r, key, "12"))
+	if err != nil || periods < 1 || periods > max {
+		return 0, false
+	}
+
+	return periods, true
+}
+
+//	@Router		/v1/guild/{guild_id}/member/{member_id}/activity-history [GET]
+//	@Tags		Members
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id		path		string	true	"The guild ID."
+//	@Param		member_id		path		string	true	"The member ID."
+//	@Param		activity_type	query		string	false	"The activity type."					Enums(chat, voice)
+//	@Param		weeks			query		int		false	"The amount of past weeks to include."	minimum(1)	maximum(52)
+//	@Param		months			query		int		false	"The amount of past months to include."	minimum(1)	maximum(24)
+//
+//	@Success	200				{object}	MemberActivityHistoryResponse
+//	@Failure	400				{object}	APIError
+//	@Failure	404				{object}	APIError
+//
+// nolint:staticcheck
+func (h *MemberHandler) GetMemberActivityHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	guildId := chi.URLParam(r, "guildId")
+	memberId := chi.URLParam(r, "memberId")
+	activityType := httpx.GetQueryParam(r, "activity_type", "chat")
+
+	weeks, weeksOk := historyPeriodsParam(r, "weeks", maxActivityHistoryWeeks)
+	months, monthsOk := historyPeriodsParam(r, "months", maxActivityHistoryMonths)
+	if !weeksOk || !monthsOk {
+		writeInvalidQueryParam(w, r)
+		return
+	}
+
+	history, err := h.uc.GetMemberActivityHistory(ctx, guildId, memberId, activityType, weeks, months)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrInvalidActivityType.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusBadRequest)
+			case u.ErrMemberProfileNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, MemberActivityHistoryResponse{
+		Data: *history,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/member/{member_id}/activity-ledger [GET]
+//	@Tags		Members
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id		path		string	true	"The guild ID."
+//	@Param		member_id		path		string	true	"The member ID."
+//	@Param		activity_type	query		string	false	"The activity type, every activity type is included when it's omitted."	Enums(chat, voice)
+//	@Param		page			query		int		false	"The page of entries, from newest to oldest."							minimum(1)
+//
+//	@Success	200				{object}	MemberActivityLedgerResponse
+//	@Failure	400				{object}	APIError
+//
+// nolint:staticcheck
+func (h *MemberHandler) GetMemberActivityLedger(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	guildId := chi.URLParam(r, "guildId")
+	memberId := chi.URLParam(r, "memberId")
+	activityType := httpx.GetQueryParam(r, "activity_type")
+	page, err := strconv.Atoi(httpx.GetQueryParam(r, "page", "1"))
+	if err != nil {
+		page = 1
+	}
+
+	ledger, err := h.uc.GetMemberActivityLedger(ctx, guildId, memberId, activityType, page)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrInvalidActivityType.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusBadRequest)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, MemberActivityLedgerResponse{
+		Data: *ledger,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
 	}
 }
 
-//	@Router	/v1/guild/{guild_id}/member/{member_id}/profile-card [GET]
-//	@Tags	Members
+//	@Router		/v1/guild/{guild_id}/member/{member_id}/profile-card [GET]
+//	@Tags		Members
+//
+//	@Security	APIKeyAuth
 //
-//	@Param	guild_id	path	string	true	"The guild ID."
-//	@Param	member_id	path	string	true	"The member ID."
+//	@Param		guild_id		path	string	true	"The guild ID."
+//	@Param		member_id		path	string	true	"The member ID."
+//	@Param		format			query	string	false	"Renders the card to an image instead of HTML."	Enum(html, png, webp)
+//	@Param		If-None-Match	header	string	false	"The ETag of a previously fetched card, a 304 is returned when it hasn't changed."
+//
+//	@Produce	html
+//	@Produce	png
+//	@Produce	image/webp
 //
 // nolint:staticcheck
 func (h *MemberHandler) GenerateMemberProfileCard(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	guildId := chi.URLParam(r, "guildId")
 	memberId := chi.URLParam(r, "memberId")
+	format := httpx.GetQueryParam(r, "format", "html")
+
+	etag, err := h.uc.GetMemberProfileCardETag(ctx, guildId, memberId, format)
+	var card *u.RenderedCard
+	if err == nil {
+		if cardNotModified(w, r, etag) {
+			return
+		}
+
+		card, err = h.uc.GenerateMemberProfileCard(ctx, guildId, memberId, format)
+	}
 
-	card, err := h.uc.GenerateMemberProfileCard(ctx, guildId, memberId)
 	if err != nil {
 		if errors.Is(err, context.Canceled) {
 			return
@@ -175,6 +430,8 @@ func (h *MemberHandler) GenerateMemberProfileCard(w http.ResponseWriter, r *http
 
 		var ueErr u.UsecaseError
 		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
 			var writeErr error
 
 			switch ueErr.Code {
@@ -188,42 +445,62 @@ func (h *MemberHandler) GenerateMemberProfileCard(w http.ResponseWriter, r *http
 					Code:    ueErr.Code,
 					Message: ueErr.Message,
 				}, http.StatusNotFound)
+			case u.ErrInvalidCardFormat.Code, u.ErrUnsupportedCardFormat.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusBadRequest)
+			case u.ErrCardRenderingDisabled.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotImplemented)
 			}
 
 			if writeErr != nil {
-				log.Error(writeErr)
+				log.WithContext(r.Context()).Error(writeErr)
 				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 			}
 
 			return
 		}
 
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
-	w.Header().Set("Content-Type", "text/html; charset=utf-8")
-	if err := card.Render(w); err != nil {
-		http.Error(w, "Failed to render profile card", http.StatusInternalServerError)
-		return
-	}
+	writeCard(w, r, card, etag)
 }
 
-//	@Router	/v1/guild/{guild_id}/member/{member_id}/chat-activity [PATCH]
-//	@Tags	Members
+//	@Router		/v1/guild/{guild_id}/member/{member_id}/chat-activity [PATCH]
+//	@Tags		Members
+//
+//	@Security	APIKeyAuth
 //
-//	@Param	guild_id	path	string	true	"The guild ID."
-//	@Param	member_id	path	string	true	"The member ID."
+//	@Param		guild_id		path		string	true	"The guild ID."
+//	@Param		member_id		path		string	true	"The member ID."
+//	@Param		channel_id		query		string	false	"The channel the message was sent in, used to apply channel multipliers."
+//	@Param		create_profile	query		bool	false	"Whether to create the member's profile when it doesn't exist, overrides the guild's setting."
+//
+//	@Success	200				{object}	ActivityGrantResponse
+//	@Success	202				{object}	ActivityGrantResponse
+//	@Failure	429				{object}	APICooldownError
 //
 // nolint:staticcheck
 func (h *MemberHandler) IncrementMemberChatActivityPoints(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	guildId := chi.URLParam(r, "guildId")
 	memberId := chi.URLParam(r, "memberId")
+	channelId := httpx.GetQueryParam(r, "channel_id")
+
+	createProfile, ok := createProfileParam(r)
+	if !ok {
+		writeInvalidQueryParam(w, r)
+		return
+	}
 
-	profile, err := h.uc.IncrementMemberChatActivityPoints(ctx, guildId, memberId)
+	grant, err := h.uc.IncrementMemberChatActivityPoints(ctx, guildId, memberId, channelId, createProfile)
 	if err != nil {
 		if errors.Is(err, context.Canceled) {
 			return
@@ -236,6 +513,8 @@ func (h *MemberHandler) IncrementMemberChatActivityPoints(w http.ResponseWriter,
 
 		var ueErr u.UsecaseError
 		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
 			var writeErr error
 
 			switch ueErr.Code {
@@ -245,11 +524,104 @@ func (h *MemberHandler) IncrementMemberChatActivityPoints(w http.ResponseWriter,
 					Message: ueErr.Message,
 				}, http.StatusNotFound)
 			case u.ErrMemberOnGrantCooldown.Code:
+				writeErr = writeCooldownError(w, err, ueErr)
+			case u.ErrChatActivityTrackingDisabled.Code:
+				fallthrough
+			case u.ErrChannelActivityExcluded.Code:
+				fallthrough
+			case u.ErrMemberGrantDenied.Code:
 				writeErr = httpx.WriteJSON(w, APIError{
 					Code:    ueErr.Code,
 					Message: ueErr.Message,
-				}, http.StatusTooManyRequests)
-			case u.ErrChatActivityTrackingDisabled.Code:
+				}, http.StatusForbidden)
+			case u.ErrMemberNotInGuild.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// When the grant was buffered, there's no updated profile to return yet.
+	status := http.StatusOK
+	if grant.MemberProfile == nil {
+		status = http.StatusAccepted
+	}
+
+	err = httpx.WriteJSON(w, ActivityGrantResponse{
+		Data: *grant,
+	}, status)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/member/{member_id}/voice-activity [PATCH]
+//	@Tags		Members
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id		path		string	true	"The guild ID."
+//	@Param		member_id		path		string	true	"The member ID."
+//	@Param		create_profile	query		bool	false	"Whether to create the member's profile when it doesn't exist, overrides the guild's setting."
+//
+//	@Success	200				{object}	ActivityGrantResponse
+//	@Failure	403				{object}	APIError
+//	@Failure	404				{object}	APIError
+//	@Failure	429				{object}	APICooldownError
+//
+// nolint:staticcheck
+func (h *MemberHandler) IncrementMemberVoiceActivityPoints(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	guildId := chi.URLParam(r, "guildId")
+	memberId := chi.URLParam(r, "memberId")
+
+	createProfile, ok := createProfileParam(r)
+	if !ok {
+		writeInvalidQueryParam(w, r)
+		return
+	}
+
+	grant, err := h.uc.IncrementMemberVoiceActivityPoints(ctx, guildId, memberId, createProfile)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrMemberProfileNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			case u.ErrMemberOnGrantCooldown.Code:
+				writeErr = writeCooldownError(w, err, ueErr)
+			case u.ErrVoiceActivityTrackingDisabled.Code:
+				fallthrough
+			case u.ErrMemberGrantDenied.Code:
 				writeErr = httpx.WriteJSON(w, APIError{
 					Code:    ueErr.Code,
 					Message: ueErr.Message,
@@ -262,33 +634,35 @@ func (h *MemberHandler) IncrementMemberChatActivityPoints(w http.ResponseWriter,
 			}
 
 			if writeErr != nil {
-				log.Error(writeErr)
+				log.WithContext(r.Context()).Error(writeErr)
 				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 			}
 
 			return
 		}
 
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	err = httpx.WriteJSON(w, MemberProfileResponse{
-		Data: *profile,
+	err = httpx.WriteJSON(w, ActivityGrantResponse{
+		Data: *grant,
 	}, http.StatusOK)
 	if err != nil {
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 	}
 }
 
-//	@Router	/v1/guild/{guild_id}/member/{member_id}/migrate [POST]
-//	@Tags	Members
+//	@Router		/v1/guild/{guild_id}/member/{member_id}/migrate [POST]
+//	@Tags		Members
 //
-//	@Param	guild_id	path	string						true	"The guild ID."
-//	@Param	member_id	path	string						true	"The member ID."
+//	@Security	APIKeyAuth
 //
-//	@Param	body		body	MigrateMemberProfileBody	true	"The migration body."
+//	@Param		guild_id	path	string						true	"The guild ID."
+//	@Param		member_id	path	string						true	"The member ID."
+//
+//	@Param		body		body	MigrateMemberProfileBody	true	"The migration body."
 //
 // nolint:staticcheck
 func (h *MemberHandler) MigrateMemberProfile(w http.ResponseWriter, r *http.Request) {
@@ -304,7 +678,7 @@ func (h *MemberHandler) MigrateMemberProfile(w http.ResponseWriter, r *http.Requ
 		}, http.StatusBadRequest)
 
 		if err != nil {
-			log.Error(err)
+			log.WithContext(r.Context()).Error(err)
 			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
 		}
 
@@ -316,7 +690,7 @@ func (h *MemberHandler) MigrateMemberProfile(w http.ResponseWriter, r *http.Requ
 		}, http.StatusBadRequest)
 
 		if err != nil {
-			log.Error(err)
+			log.WithContext(r.Context()).Error(err)
 			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
 		}
 
@@ -329,7 +703,7 @@ func (h *MemberHandler) MigrateMemberProfile(w http.ResponseWriter, r *http.Requ
 		}, http.StatusBadRequest)
 
 		if err != nil {
-			log.Error(err)
+			log.WithContext(r.Context()).Error(err)
 			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
 		}
 
@@ -349,6 +723,8 @@ func (h *MemberHandler) MigrateMemberProfile(w http.ResponseWriter, r *http.Requ
 
 		var ueErr u.UsecaseError
 		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
 			var writeErr error
 
 			switch ueErr.Code {
@@ -360,14 +736,14 @@ func (h *MemberHandler) MigrateMemberProfile(w http.ResponseWriter, r *http.Requ
 			}
 
 			if writeErr != nil {
-				log.Error(writeErr)
+				log.WithContext(r.Context()).Error(writeErr)
 				http.Error(w, ueErr.Message, http.StatusInternalServerError)
 			}
 
 			return
 		}
 
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
 		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -376,6 +752,441 @@ func (h *MemberHandler) MigrateMemberProfile(w http.ResponseWriter, r *http.Requ
 		Data: nil,
 	}, http.StatusOK)
 	if err != nil {
-		log.Error(err)
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/member/{member_id}/voice-session [GET]
+//	@Tags		Members
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id	path		string	true	"The guild ID."
+//	@Param		member_id	path		string	true	"The member ID."
+//
+//	@Success	200			{object}	VoiceSessionResponse
+//	@Failure	404			{object}	APIError
+//
+// nolint:staticcheck
+func (h *MemberHandler) GetMemberVoiceSession(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	guildId := chi.URLParam(r, "guildId")
+	memberId := chi.URLParam(r, "memberId")
+
+	session, err := h.uc.GetMemberVoiceSession(ctx, guildId, memberId)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrVoiceSessionNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, VoiceSessionResponse{
+		Data: *session,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/member/{member_id}/voice-session [POST]
+//	@Tags		Members
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id		path		string					true	"The guild ID."
+//	@Param		member_id		path		string					true	"The member ID."
+//	@Param		create_profile	query		bool					false	"Whether to create the member's profile when it doesn't exist, overrides the guild's setting."
+//
+//	@Param		body			body		VoiceSessionStateBody	true	"The member's current voice state."
+//
+//	@Success	201				{object}	VoiceSessionResponse
+//	@Failure	400				{object}	APIError
+//	@Failure	403				{object}	APIError
+//	@Failure	404				{object}	APIError
+//	@Failure	409				{object}	APIError
+//
+// nolint:staticcheck
+func (h *MemberHandler) OpenMemberVoiceSession(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	guildId := chi.URLParam(r, "guildId")
+	memberId := chi.URLParam(r, "memberId")
+
+	createProfile, ok := createProfileParam(r)
+	if !ok {
+		writeInvalidQueryParam(w, r)
+		return
+	}
+
+	var body *VoiceSessionStateBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: ErrInvalidRequestBody.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+	if err := body.Validate(); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	session, err := h.uc.OpenMemberVoiceSession(ctx, guildId, memberId, u.VoiceSessionState(*body), createProfile)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrVoiceActivityTrackingDisabled.Code:
+				fallthrough
+			case u.ErrMemberGrantDenied.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusForbidden)
+			case u.ErrMemberNotInGuild.Code:
+				fallthrough
+			case u.ErrMemberProfileNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			case u.ErrVoiceSessionExists.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusConflict)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, VoiceSessionResponse{
+		Data: *session,
+	}, http.StatusCreated)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/member/{member_id}/voice-session [PATCH]
+//	@Tags		Members
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id	path		string					true	"The guild ID."
+//	@Param		member_id	path		string					true	"The member ID."
+//
+//	@Param		body		body		VoiceSessionStateBody	true	"The member's current voice state."
+//
+//	@Success	200			{object}	VoiceSessionResponse
+//	@Failure	400			{object}	APIError
+//	@Failure	404			{object}	APIError
+//
+// nolint:staticcheck
+func (h *MemberHandler) UpdateMemberVoiceSession(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	guildId := chi.URLParam(r, "guildId")
+	memberId := chi.URLParam(r, "memberId")
+
+	var body *VoiceSessionStateBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: ErrInvalidRequestBody.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+	if err := body.Validate(); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	session, err := h.uc.UpdateMemberVoiceSession(ctx, guildId, memberId, u.VoiceSessionState(*body))
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrVoiceSessionNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, VoiceSessionResponse{
+		Data: *session,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/member/{member_id}/voice-session [DELETE]
+//	@Tags		Members
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id	path		string	true	"The guild ID."
+//	@Param		member_id	path		string	true	"The member ID."
+//
+//	@Success	200			{object}	ClosedVoiceSessionResponse
+//	@Failure	404			{object}	APIError
+//
+// nolint:staticcheck
+func (h *MemberHandler) CloseMemberVoiceSession(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	guildId := chi.URLParam(r, "guildId")
+	memberId := chi.URLParam(r, "memberId")
+
+	session, err := h.uc.CloseMemberVoiceSession(ctx, guildId, memberId)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrVoiceSessionNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, ClosedVoiceSessionResponse{
+		Data: *session,
+	}, http.StatusOK)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+	}
+}
+
+//	@Router		/v1/guild/{guild_id}/activity-adjustments [POST]
+//	@Tags		Members
+//
+//	@Security	APIKeyAuth
+//
+//	@Param		guild_id	path		string					true	"The guild ID."
+//	@Param		X-Actor-ID	header		string					true	"The user making the adjustment."
+//	@Param		body		body		ActivityAdjustmentBody	true	"The members to adjust and the reason for it."
+//
+//	@Success	201			{object}	ActivityAdjustmentsResponse
+//	@Failure	400			{object}	APIError
+//	@Failure	404			{object}	APIError
+//
+// nolint:staticcheck
+func (h *MemberHandler) AdjustMemberActivityPoints(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	guildId := chi.URLParam(r, "guildId")
+	var body *ActivityAdjustmentBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: ErrInvalidRequestBody.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	if err := body.Validate(); err != nil {
+		err := httpx.WriteJSON(w, APIError{
+			Message: err.Error(),
+		}, http.StatusBadRequest)
+
+		if err != nil {
+			log.WithContext(r.Context()).Error(err)
+			http.Error(w, ErrInvalidRequestBody.Error(), http.StatusBadRequest)
+		}
+
+		return
+	}
+
+	adjustments, err := h.uc.AdjustMemberActivityPoints(ctx, guildId, u.AdjustActivityPointsOpts(*body))
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+			return
+		}
+
+		var ueErr u.UsecaseError
+		if errors.As(err, &ueErr) {
+			logUsecaseError(r, ueErr)
+
+			var writeErr error
+
+			switch ueErr.Code {
+			case u.ErrInvalidActivityType.Code:
+				fallthrough
+			case u.ErrInvalidActivityAdjustment.Code:
+				fallthrough
+			case u.ErrActivityAdjustmentNoActor.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusBadRequest)
+			case u.ErrGuildNotFound.Code:
+				fallthrough
+			case u.ErrMemberProfileNotFound.Code:
+				writeErr = httpx.WriteJSON(w, APIError{
+					Code:    ueErr.Code,
+					Message: ueErr.Message,
+				}, http.StatusNotFound)
+			}
+
+			if writeErr != nil {
+				log.WithContext(r.Context()).Error(writeErr)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = httpx.WriteJSON(w, ActivityAdjustmentsResponse{
+		Data: adjustments,
+	}, http.StatusCreated)
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
 	}
 }
@@ -1,14 +1,45 @@
 package handlers
 
 import (
-	"fmt"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"net/http"
+	"path"
+	"strconv"
+	"strings"
 	"time"
+
+	"github.com/go-chi/chi"
+	log "github.com/sirupsen/logrus"
+	"github.com/typical-developers/discord-bot-backend/internal/logger"
+	"github.com/typical-developers/discord-bot-backend/internal/metrics"
+	u "github.com/typical-developers/discord-bot-backend/internal/usecase"
+	"github.com/typical-developers/discord-bot-backend/pkg/httpx"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type contextKey string
+
+const (
+	apiKeyContextKey      contextKey = "api_key"
+	publicRouteContextKey contextKey = "public_route"
+)
+
+// Requests can provide their own ID to correlate logs across services, as long as it's reasonable.
+const (
+	requestIdHeader                 = "X-Request-ID"
+	maxRequestIdLength              = 128
+	requestLogContextKey contextKey = "request_log"
 )
 
 type ResponseStatus struct {
 	http.ResponseWriter
 	StatusCode int
+	Bytes      int
 }
 
 func (r *ResponseStatus) WriteHeader(code int) {
@@ -16,18 +47,283 @@ func (r *ResponseStatus) WriteHeader(code int) {
 	r.ResponseWriter.WriteHeader(code)
 }
 
+func (r *ResponseStatus) Write(b []byte) (int, error) {
+	n, err := r.ResponseWriter.Write(b)
+	r.Bytes += n
+
+	return n, err
+}
+
+// requestLogEntry holds details about the request that are only known by the handler.
+type requestLogEntry struct {
+	errorCode string
+}
+
+// requestID uses the request's X-Request-ID header when it's valid, otherwise a new ID is generated.
+func requestID(r *http.Request) string {
+	requestId := r.Header.Get(requestIdHeader)
+	if requestId != "" && len(requestId) <= maxRequestIdLength && strings.IndexFunc(requestId, func(c rune) bool {
+		return c < '!' || c > '~'
+	}) == -1 {
+		return requestId
+	}
+
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
+
+// RequestLog assigns every request an ID and logs it once it's been handled.
+// The ID is returned in the X-Request-ID header and attached to the request context, so it's included in logs made with it.
 func RequestLog(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
 
-		rs := &ResponseStatus{ResponseWriter: w}
+		requestId := requestID(r)
+		w.Header().Set(requestIdHeader, requestId)
+
+		entry := &requestLogEntry{}
+		ctx := logger.WithRequestID(r.Context(), requestId)
+		ctx = context.WithValue(ctx, requestLogContextKey, entry)
+
+		rs := &ResponseStatus{ResponseWriter: w, StatusCode: http.StatusOK}
+		handler.ServeHTTP(rs, r.WithContext(ctx))
+
+		fields := log.Fields{
+			"method":      r.Method,
+			"path":        r.URL.Path,
+			"status":      rs.StatusCode,
+			"latency_ms":  float64(time.Since(start).Microseconds()) / 1000,
+			"bytes":       rs.Bytes,
+			"remote_addr": r.RemoteAddr,
+		}
+
+		if route := routePattern(r); route != "" {
+			fields["route"] = route
+		}
+
+		if rctx := chi.RouteContext(ctx); rctx != nil {
+			if guildId := rctx.URLParam("guildId"); guildId != "" {
+				fields["guild_id"] = guildId
+			}
+
+			if memberId := rctx.URLParam("memberId"); memberId != "" {
+				fields["member_id"] = memberId
+			}
+		}
+
+		if entry.errorCode != "" {
+			fields["error_code"] = entry.errorCode
+		}
+
+		requestLogger := log.WithContext(ctx).WithFields(fields)
+		if rs.StatusCode >= http.StatusInternalServerError {
+			requestLogger.Error("Request failed.")
+			return
+		}
+
+		requestLogger.Info("Request handled.")
+	})
+}
+
+// The bot passes the ID of the user that made a request, so settings changes can be attributed to them.
+const (
+	actorIdHeader    = "X-Actor-ID"
+	maxActorIdLength = 20
+)
+
+// Actor attaches the user ID from the X-Actor-ID header to the request context, requests without one aren't attributed to anyone.
+// The header is rejected when it isn't a Discord user ID.
+func Actor(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actorId := r.Header.Get(actorIdHeader)
+		if actorId == "" {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		if len(actorId) > maxActorIdLength || strings.IndexFunc(actorId, func(c rune) bool {
+			return c < '0' || c > '9'
+		}) != -1 {
+			writeAuthError(w, r, u.ErrInvalidActorID, http.StatusBadRequest)
+			return
+		}
+
+		handler.ServeHTTP(w, r.WithContext(u.WithActorID(r.Context(), actorId)))
+	})
+}
+
+// logUsecaseError adds the usecase error's code to the request's log.
+func logUsecaseError(r *http.Request, ueErr u.UsecaseError) {
+	if entry, ok := r.Context().Value(requestLogContextKey).(*requestLogEntry); ok {
+		entry.errorCode = ueErr.Code
+	}
+}
+
+// routePattern gets the route pattern the request matched, i.e. "/v1/guild/{guildId}/settings".
+// The pattern is only known once the router has matched the request, so this is empty before the handler runs.
+func routePattern(r *http.Request) string {
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil {
+		return ""
+	}
+
+	return rctx.RoutePattern()
+}
+
+// Tracing starts a span for every request, continuing the trace from the W3C trace context headers when they're provided.
+func Tracing(handler http.Handler) http.Handler {
+	return otelhttp.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(w, r)
+
+		// The span is renamed once the route is known, so requests are grouped by route instead of by path.
+		if route := routePattern(r); route != "" {
+			span := trace.SpanFromContext(r.Context())
+			span.SetName(r.Method + " " + route)
+			span.SetAttributes(attribute.String("http.route", route))
+		}
+	}), "http", otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+		return r.Method
+	}))
+}
+
+// RequestMetrics records the request count and latency by the matched route pattern,
+// so routes with path parameters are grouped together instead of by their full path.
+func RequestMetrics(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		rs := &ResponseStatus{ResponseWriter: w, StatusCode: http.StatusOK}
 		handler.ServeHTTP(rs, r)
 
-		end := time.Now()
-		elapsed := end.Sub(start)
+		route := routePattern(r)
+		if route == "" {
+			route = "unmatched"
+		}
+		status := strconv.Itoa(rs.StatusCode)
 
-		fmt.Printf("%s | %-4d | %-6s | %-10s | %-15s | %s\n",
-			start.Format(time.RFC3339), rs.StatusCode, r.Method, elapsed, r.RemoteAddr, r.URL,
-		)
+		metrics.HTTPRequests.WithLabelValues(route, r.Method, status).Inc()
+		metrics.HTTPRequestDuration.WithLabelValues(route, r.Method, status).Observe(time.Since(start).Seconds())
 	})
 }
+
+// APIKeyFromContext returns the API key that authenticated the request.
+// This will be nil for public routes.
+func APIKeyFromContext(ctx context.Context) *u.APIKey {
+	key, _ := ctx.Value(apiKeyContextKey).(*u.APIKey)
+	return key
+}
+
+// isPublicRoute checks the path against the configured public route patterns.
+//
+// Patterns ending with "/*" match everything under that prefix,
+// otherwise "*" only matches a single path segment (i.e. "/v1/guild/*/settings").
+func isPublicRoute(publicRoutes []string, urlPath string) bool {
+	for _, pattern := range publicRoutes {
+		if pattern == "" {
+			continue
+		}
+
+		if prefix, ok := strings.CutSuffix(pattern, "/*"); ok && strings.HasPrefix(urlPath, prefix+"/") {
+			return true
+		}
+
+		if matched, _ := path.Match(strings.TrimSuffix(pattern, "/"), strings.TrimSuffix(urlPath, "/")); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+func writeAuthError(w http.ResponseWriter, r *http.Request, ueErr u.UsecaseError, status int) {
+	logUsecaseError(r, ueErr)
+
+	err := httpx.WriteJSON(w, APIError{
+		Code:    ueErr.Code,
+		Message: ueErr.Message,
+	}, status)
+
+	if err != nil {
+		log.WithContext(r.Context()).Error(err)
+		http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+	}
+}
+
+// Authenticate validates the X-API-KEY header for every request that isn't a public route.
+// The authenticated key is attached to the request context.
+func Authenticate(uc u.AuthUsecase, publicRoutes []string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			if isPublicRoute(publicRoutes, r.URL.Path) {
+				ctx = context.WithValue(ctx, publicRouteContextKey, true)
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
+			key, err := uc.Authenticate(ctx, r.Header.Get("X-API-KEY"))
+			if err != nil {
+				if errors.Is(err, context.Canceled) {
+					return
+				}
+
+				if errors.Is(err, context.DeadlineExceeded) {
+					http.Error(w, ErrGatewayTimeout.Error(), http.StatusGatewayTimeout)
+					return
+				}
+
+				var ueErr u.UsecaseError
+				if errors.As(err, &ueErr) {
+					writeAuthError(w, r, ueErr, http.StatusUnauthorized)
+					return
+				}
+
+				log.WithContext(r.Context()).Error(err)
+				http.Error(w, ErrInternalError.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			ctx = context.WithValue(ctx, apiKeyContextKey, key)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequireScope only allows the request through if the API key has any of the provided scopes.
+// Public routes are always allowed through.
+func RequireScope(scopes ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			if public, _ := ctx.Value(publicRouteContextKey).(bool); public {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			key := APIKeyFromContext(ctx)
+			if key == nil {
+				writeAuthError(w, r, u.ErrAPIKeyMissing, http.StatusUnauthorized)
+				return
+			}
+
+			if !key.HasScope(scopes...) {
+				writeAuthError(w, r, u.ErrAPIKeyInsufficientScope, http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+var (
+	requireRead          = RequireScope(u.ScopeReadOnly, u.ScopeSettingsWrite, u.ScopeActivityGrant)
+	requireSettingsWrite = RequireScope(u.ScopeSettingsWrite)
+	requireActivityGrant = RequireScope(u.ScopeActivityGrant)
+	requireHTML          = RequireScope(u.ScopeHTMLOnly, u.ScopeReadOnly)
+	requireAdmin         = RequireScope(u.ScopeAdmin)
+)
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+	log "github.com/sirupsen/logrus"
+	u "github.com/typical-developers/discord-bot-backend/internal/usecase"
+	"github.com/typical-developers/discord-bot-backend/pkg/httpx"
+	"github.com/typical-developers/discord-bot-backend/pkg/ratelimit"
+)
+
+// The route groups that have their own rate limits.
+const (
+	RateLimitDefault        = "default"
+	RateLimitActivityGrants = "activity-grants"
+	RateLimitCards          = "cards"
+	RateLimitAPIKeys        = "api-keys"
+	// Used before the request is authenticated, so it's always limited by the client's address.
+	RateLimitClients = "clients"
+)
+
+// RateLimiter limits requests per API key and guild, with separate limits for each route group.
+// A nil RateLimiter doesn't limit anything.
+type RateLimiter struct {
+	limiter *ratelimit.Limiter
+	limits  map[string]ratelimit.Limit
+}
+
+func NewRateLimiter(limiter *ratelimit.Limiter, limits map[string]ratelimit.Limit) *RateLimiter {
+	return &RateLimiter{limiter: limiter, limits: limits}
+}
+
+// rateLimitKey identifies who the request is limited as.
+// Public routes don't have an API key, so they're limited by the client's address instead.
+func rateLimitKey(r *http.Request, group string) string {
+	client := "ip:" + r.RemoteAddr
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		client = "ip:" + host
+	}
+
+	if key := APIKeyFromContext(r.Context()); key != nil {
+		client = "key:" + key.KeyID
+	}
+
+	return fmt.Sprintf("ratelimit:%s:%s:%s", group, client, chi.URLParam(r, "guildId"))
+}
+
+// Group limits the routes using the route group's limit.
+func (rl *RateLimiter) Group(group string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if rl == nil {
+			return next
+		}
+
+		limit, ok := rl.limits[group]
+		if !ok || limit.Rate <= 0 || limit.Burst <= 0 {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			allowed, retryAfter, err := rl.limiter.Allow(r.Context(), rateLimitKey(r, group), limit)
+			if err != nil {
+				// Rate limiting is best-effort, requests are let through when redis is unavailable.
+				log.WithContext(r.Context()).WithError(err).Warn("Failed to check the rate limit.")
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if !allowed {
+				logUsecaseError(r, u.ErrRateLimited)
+
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+				err := httpx.WriteJSON(w, APIError{
+					Code:    u.ErrRateLimited.Code,
+					Message: u.ErrRateLimited.Message,
+				}, http.StatusTooManyRequests)
+				if err != nil {
+					log.WithContext(r.Context()).Error(err)
+				}
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
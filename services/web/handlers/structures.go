@@ -12,13 +12,39 @@ type APIError struct {
 	Message string `json:"message"`
 }
 
+// APICooldownError is returned when the member is on cooldown, with how many seconds are left until they can be granted points again.
+type APICooldownError struct {
+	APIError
+	RemainingSeconds int32 `json:"remaining_seconds"`
+}
+
+// --- Health
+type HealthStatus struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+type HealthResponse APIResponse[HealthStatus]
+
 // --- Guild Settings
 type GuildSettingsResponse APIResponse[u.GuildSettings]
 
 type GuildActivitySettingsUpdateBody u.UpdateAcitivtySettings
 
+func validRoleAssignmentMode(mode *string) bool {
+	return mode == nil || *mode == "stack" || *mode == "replace"
+}
+
 func (u GuildActivitySettingsUpdateBody) Validate() error {
-	if u.ChatActivity == nil {
+	if u.ChatActivity == nil && u.VoiceActivity == nil {
+		return ErrInvalidRequestBody
+	}
+
+	if u.ChatActivity != nil && !validRoleAssignmentMode(u.ChatActivity.RoleAssignmentMode) {
+		return ErrInvalidRequestBody
+	}
+
+	if u.VoiceActivity != nil && !validRoleAssignmentMode(u.VoiceActivity.RoleAssignmentMode) {
 		return ErrInvalidRequestBody
 	}
 
@@ -31,6 +57,116 @@ type GuildActivityRoleCreateBody struct {
 	RequiredPoints int32  `json:"required_points"`
 }
 
+func (b GuildActivityRoleCreateBody) Validate() error {
+	if b.RoleID == "" || b.RequiredPoints < 0 {
+		return ErrInvalidRequestBody
+	}
+
+	if b.ActivityType != "chat" && b.ActivityType != "voice" {
+		return ErrInvalidRequestBody
+	}
+
+	return nil
+}
+
+type GuildActivityRoleUpdateBody u.UpdateActivityRoleOpts
+
+func (b GuildActivityRoleUpdateBody) Validate() error {
+	if b.ActivityType == nil && b.RequiredPoints == nil {
+		return ErrInvalidRequestBody
+	}
+
+	if b.ActivityType != nil && *b.ActivityType != "chat" && *b.ActivityType != "voice" {
+		return ErrInvalidRequestBody
+	}
+
+	if b.RequiredPoints != nil && *b.RequiredPoints < 0 {
+		return ErrInvalidRequestBody
+	}
+
+	return nil
+}
+
+type GuildActivityRoleResponse APIResponse[u.GuildActivityRole]
+type GuildActivityRolesResponse APIResponse[[]u.GuildActivityRole]
+
+type GuildActivityDenyRoleBody struct {
+	RoleID string `json:"role_id"`
+}
+
+func (b GuildActivityDenyRoleBody) Validate() error {
+	if b.RoleID == "" {
+		return ErrInvalidRequestBody
+	}
+
+	return nil
+}
+
+type GuildActivityDenyRolesResponse APIResponse[[]string]
+
+type GuildActivityChannelMultiplierBody struct {
+	Multiplier *float32 `json:"multiplier"`
+}
+
+func (b GuildActivityChannelMultiplierBody) Validate() error {
+	if b.Multiplier == nil || *b.Multiplier < 0 {
+		return ErrInvalidRequestBody
+	}
+
+	return nil
+}
+
+type GuildActivityChannelMultiplierResponse APIResponse[u.GuildActivityChannelMultiplier]
+
+type GuildActivityChannelMultipliersResponse APIResponse[[]u.GuildActivityChannelMultiplier]
+
+type GuildAuditLogChannelBody struct {
+	// An empty channel ID stops settings changes from being posted.
+	ChannelID *string `json:"channel_id"`
+}
+
+func (b GuildAuditLogChannelBody) Validate() error {
+	if b.ChannelID == nil {
+		return ErrInvalidRequestBody
+	}
+
+	return nil
+}
+
+type GuildSettingsAuditLogResponse APIResponse[u.GuildSettingsAuditLog]
+
+type ActivityBoostCreateBody u.CreateActivityBoostOpts
+
+func (b ActivityBoostCreateBody) Validate() error {
+	if (b.ActivityType != "chat" && b.ActivityType != "voice") || b.Multiplier <= 0 || b.EndsAt <= b.StartsAt {
+		return ErrInvalidRequestBody
+	}
+
+	return nil
+}
+
+type ActivityBoostUpdateBody u.UpdateActivityBoostOpts
+
+func (b ActivityBoostUpdateBody) Validate() error {
+	if b.Name == nil && b.Multiplier == nil && b.RoleIDs == nil && b.StartsAt == nil && b.EndsAt == nil {
+		return ErrInvalidRequestBody
+	}
+
+	if b.Multiplier != nil && *b.Multiplier <= 0 {
+		return ErrInvalidRequestBody
+	}
+
+	return nil
+}
+
+type ActivityBoostResponse APIResponse[u.ActivityBoost]
+
+type ActivityBoostsResponse APIResponse[[]u.ActivityBoost]
+
+type ActivityRoleResyncJobResponse APIResponse[u.ActivityRoleResyncJob]
+
+type ArchivedLeaderboardPeriodsResponse APIResponse[[]u.ArchivedLeaderboardPeriod]
+
 type GuildMessageEmbedSettingsUpdateBody u.UpdateMessageEmbedSettingsOpts
 
 func (u GuildMessageEmbedSettingsUpdateBody) Validate() error {
@@ -60,3 +196,64 @@ func (m MigrateMemberProfileBody) Validate() error {
 }
 
 type MemberProfileResponse APIResponse[u.MemberProfile]
+type ActivityGrantResponse APIResponse[u.ActivityGrant]
+type MemberActivityHistoryResponse APIResponse[u.MemberActivityHistory]
+type MemberActivityLedgerResponse APIResponse[u.MemberActivityLedger]
+
+// The most members that can be adjusted in a single request.
+const maxActivityAdjustmentMembers = 100
+
+type ActivityAdjustmentBody u.AdjustActivityPointsOpts
+
+func (b ActivityAdjustmentBody) Validate() error {
+	if len(b.Members) == 0 || len(b.Members) > maxActivityAdjustmentMembers {
+		return ErrInvalidRequestBody
+	}
+
+	if b.Reason == "" {
+		return ErrInvalidRequestBody
+	}
+
+	for _, member := range b.Members {
+		if member.MemberID == "" {
+			return ErrInvalidRequestBody
+		}
+	}
+
+	return nil
+}
+
+type ActivityAdjustmentsResponse APIResponse[[]u.ActivityAdjustment]
+
+type VoiceSessionStateBody u.VoiceSessionState
+
+func (v VoiceSessionStateBody) Validate() error {
+	if v.ChannelID == "" {
+		return ErrInvalidRequestBody
+	}
+
+	return nil
+}
+
+type VoiceSessionResponse APIResponse[u.VoiceSession]
+
+type ClosedVoiceSessionResponse APIResponse[u.ClosedVoiceSession]
+
+// --- API Keys
+type APIKeyResponse APIResponse[u.APIKey]
+
+type APIKeysResponse APIResponse[[]u.APIKey]
+
+type CreatedAPIKeyResponse APIResponse[u.CreatedAPIKey]
+
+type APIKeyCreateBody u.CreateAPIKeyOpts
+
+type APIKeyUpdateBody u.UpdateAPIKeyOpts
+
+func (k APIKeyUpdateBody) Validate() error {
+	if k.Name == nil && k.ExpiresAt == nil {
+		return ErrInvalidRequestBody
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/typical-developers/discord-bot-backend/internal/db"
+	u "github.com/typical-developers/discord-bot-backend/internal/usecase"
+)
+
+func toActivityAdjustment(adjustment db.GuildActivityAdjustment) u.ActivityAdjustment {
+	return u.ActivityAdjustment{
+		AdjustmentID:         adjustment.AdjustmentID,
+		MemberID:             adjustment.MemberID,
+		ActivityType:         adjustment.GrantType,
+		Operation:            adjustment.Operation,
+		Amount:               adjustment.Amount,
+		PreviousPoints:       adjustment.PreviousPoints,
+		Points:               adjustment.NewPoints,
+		AdjustedLeaderboards: adjustment.AdjustedLeaderboards,
+		Reason:               adjustment.Reason,
+		ActorID:              adjustment.ActorID,
+		CreatedAt:            int64(adjustment.InsertEpoch),
+	}
+}
+
+// adjustedPoints applies the operation to the member's current points.
+// Points are kept between 0 and the largest value the column can hold.
+func adjustedPoints(operation string, current int32, amount int32) int32 {
+	switch operation {
+	case "add":
+		return int32(min(int64(current)+int64(amount), math.MaxInt32))
+	case "subtract":
+		return int32(max(int64(current)-int64(amount), 0))
+	default:
+		return amount
+	}
+}
+
+// adjustLeaderboards applies the change in the member's points to the current weekly and monthly leaderboards.
+func adjustLeaderboards(ctx context.Context, q db.Querier, guildId string, userId string, grantType string, change int32) error {
+	if change > 0 {
+		err := q.IncrementWeeklyActivityLeaderboard(ctx, db.IncrementWeeklyActivityLeaderboardParams{
+			GrantType:    grantType,
+			GuildID:      guildId,
+			MemberID:     userId,
+			EarnedPoints: change,
+		})
+		if err != nil {
+			return err
+		}
+
+		return q.IncrementMonthlyActivityLeaderboard(ctx, db.IncrementMonthlyActivityLeaderboardParams{
+			GrantType:    grantType,
+			GuildID:      guildId,
+			MemberID:     userId,
+			EarnedPoints: change,
+		})
+	}
+
+	if change < 0 {
+		err := q.DeductWeeklyActivityLeaderboard(ctx, db.DeductWeeklyActivityLeaderboardParams{
+			GrantType: grantType,
+			GuildID:   guildId,
+			MemberID:  userId,
+			Points:    -change,
+		})
+		if err != nil {
+			return err
+		}
+
+		return q.DeductMonthlyActivityLeaderboard(ctx, db.DeductMonthlyActivityLeaderboardParams{
+			GrantType: grantType,
+			GuildID:   guildId,
+			MemberID:  userId,
+			Points:    -change,
+		})
+	}
+
+	return nil
+}
+
+// adjustMemberActivityPoints adjusts a single member's points and records the adjustment.
+func adjustMemberActivityPoints(ctx context.Context, q db.Querier, guildId string, actorId string, opts u.AdjustActivityPointsOpts, member u.ActivityAdjustmentMember) (*u.ActivityAdjustment, error) {
+	current, err := q.GetMemberActivityPointsForUpdate(ctx, db.GetMemberActivityPointsForUpdateParams{
+		GuildID:  guildId,
+		MemberID: member.MemberID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, u.ErrMemberProfileNotFound
+		}
+
+		return nil, err
+	}
+
+	previous := current.ChatActivity
+	if opts.ActivityType == "voice" {
+		previous = current.VoiceActivity
+	}
+	points := adjustedPoints(opts.Operation, previous, member.Points)
+
+	err = q.SetMemberActivityPoints(ctx, db.SetMemberActivityPointsParams{
+		GuildID:   guildId,
+		MemberID:  member.MemberID,
+		GrantType: opts.ActivityType,
+		Points:    points,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.AdjustLeaderboards {
+		if err := adjustLeaderboards(ctx, q, guildId, member.MemberID, opts.ActivityType, points-previous); err != nil {
+			return nil, err
+		}
+	}
+
+	row, err := q.InsertActivityAdjustment(ctx, db.InsertActivityAdjustmentParams{
+		GuildID:              guildId,
+		MemberID:             member.MemberID,
+		GrantType:            opts.ActivityType,
+		Operation:            opts.Operation,
+		Amount:               member.Points,
+		PreviousPoints:       previous,
+		NewPoints:            points,
+		AdjustedLeaderboards: opts.AdjustLeaderboards,
+		Reason:               opts.Reason,
+		ActorID:              actorId,
+	})
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+			return nil, u.ErrGuildNotFound
+		}
+
+		return nil, err
+	}
+
+	if points != previous {
+		err = q.InsertActivityLedgerEntry(ctx, db.InsertActivityLedgerEntryParams{
+			GuildID:   guildId,
+			MemberID:  member.MemberID,
+			GrantType: opts.ActivityType,
+			Source:    "adjustment",
+			Amount:    points - previous,
+			Reference: strconv.Itoa(int(row.AdjustmentID)),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	adjustment := toActivityAdjustment(row)
+	return &adjustment, nil
+}
+
+func (uc *MemberUsecase) AdjustMemberActivityPoints(ctx context.Context, guildId string, opts u.AdjustActivityPointsOpts) ([]u.ActivityAdjustment, error) {
+	if opts.ActivityType != "chat" && opts.ActivityType != "voice" {
+		return nil, u.ErrInvalidActivityType
+	}
+
+	if opts.Operation != "add" && opts.Operation != "subtract" && opts.Operation != "set" {
+		return nil, u.ErrInvalidActivityAdjustment
+	}
+
+	actorId := u.ActorID(ctx)
+	if actorId == "" {
+		return nil, u.ErrActivityAdjustmentNoActor
+	}
+
+	// Members are adjusted in a consistent order, so concurrent adjustments lock their profiles in the same order.
+	members := slices.Clone(opts.Members)
+	slices.SortFunc(members, func(a, b u.ActivityAdjustmentMember) int {
+		return strings.Compare(a.MemberID, b.MemberID)
+	})
+	for i, member := range members {
+		if member.Points < 0 || (i > 0 && members[i-1].MemberID == member.MemberID) {
+			return nil, u.ErrInvalidActivityAdjustment
+		}
+	}
+
+	tx, err := uc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	q := uc.q.InTx(tx)
+
+	adjustments := make([]u.ActivityAdjustment, 0, len(members))
+	for _, member := range members {
+		adjustment, err := adjustMemberActivityPoints(ctx, q, guildId, actorId, opts, member)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+
+		adjustments = append(adjustments, *adjustment)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	bumpCardDataVersion(ctx, uc.c, guildId)
+	return adjustments, nil
+}
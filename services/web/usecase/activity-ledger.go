@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typical-developers/discord-bot-backend/internal/db"
+	u "github.com/typical-developers/discord-bot-backend/internal/usecase"
+)
+
+// The amount of ledger entries shown on a single page.
+const activityLedgerPageSize = 50
+
+func toActivityLedgerEntry(entry db.GuildActivityLedger) u.ActivityLedgerEntry {
+	return u.ActivityLedgerEntry{
+		EntryID:      entry.EntryID,
+		ActivityType: entry.GrantType,
+		Source:       entry.Source,
+		Amount:       entry.Amount,
+		ChannelID:    entry.ChannelID,
+		Reference:    entry.Reference,
+		CreatedAt:    int64(entry.InsertEpoch),
+	}
+}
+
+func (uc *MemberUsecase) GetMemberActivityLedger(ctx context.Context, guildId string, userId string, activityType string, page int) (*u.MemberActivityLedger, error) {
+	var grantType sql.NullString
+	if activityType != "" {
+		if activityType != "chat" && activityType != "voice" {
+			return nil, u.ErrInvalidActivityType
+		}
+
+		grantType = sql.NullString{String: activityType, Valid: true}
+	}
+
+	if page < 1 {
+		page = 1
+	}
+
+	total, err := uc.q.CountMemberActivityLedger(ctx, db.CountMemberActivityLedgerParams{
+		GuildID:   guildId,
+		MemberID:  userId,
+		GrantType: grantType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := uc.q.GetMemberActivityLedger(ctx, db.GetMemberActivityLedgerParams{
+		GuildID:   guildId,
+		MemberID:  userId,
+		GrantType: grantType,
+		LimitBy:   activityLedgerPageSize,
+		OffsetBy:  int32(page-1) * activityLedgerPageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]u.ActivityLedgerEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, toActivityLedgerEntry(row))
+	}
+
+	totalPages := (total + activityLedgerPageSize - 1) / activityLedgerPageSize
+	return &u.MemberActivityLedger{
+		CurrentPage: int32(page),
+		TotalPages:  totalPages,
+		HasNextPage: int32(page) < totalPages,
+		Entries:     entries,
+	}, nil
+}
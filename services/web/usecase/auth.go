@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/typical-developers/discord-bot-backend/internal/db"
+	db_cache "github.com/typical-developers/discord-bot-backend/pkg/db-cache"
+	"github.com/typical-developers/discord-bot-backend/pkg/sqlx"
+
+	u "github.com/typical-developers/discord-bot-backend/internal/usecase"
+)
+
+type AuthUsecase struct {
+	db *sql.DB
+	q  db.TxQuerier
+	c  *db_cache.Cache
+
+	masterKey string
+}
+
+func NewAuthUsecase(db *sql.DB, q db.TxQuerier, c *db_cache.Cache, masterKey string) u.AuthUsecase {
+	return &AuthUsecase{db: db, q: q, c: c, masterKey: masterKey}
+}
+
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+func toAPIKey(key db.ApiKey) u.APIKey {
+	apiKey := u.APIKey{
+		KeyID:     key.KeyID,
+		Name:      key.Name,
+		Scopes:    key.Scopes,
+		CreatedAt: int64(key.InsertEpoch),
+	}
+
+	if key.ExpiresAt.Valid {
+		expiresAt := int64(key.ExpiresAt.Int32)
+		apiKey.ExpiresAt = &expiresAt
+	}
+
+	return apiKey
+}
+
+func (uc *AuthUsecase) Authenticate(ctx context.Context, key string) (*u.APIKey, error) {
+	if key == "" {
+		return nil, u.ErrAPIKeyMissing
+	}
+
+	if uc.masterKey != "" && subtle.ConstantTimeCompare([]byte(key), []byte(uc.masterKey)) == 1 {
+		return &u.APIKey{
+			KeyID:  "master",
+			Name:   "Master Key",
+			Scopes: u.APIKeyScopes,
+		}, nil
+	}
+
+	// Invalid keys aren't cached, those requests are limited by the client's address before they get here.
+	keyHash := hashAPIKey(key)
+	apiKey, err := db_cache.GetWithTTL(ctx, uc.c, apiKeyCacheKey(keyHash), apiKeyCacheTTL, func(ctx context.Context) (u.APIKey, error) {
+		apiKey, err := uc.q.GetAPIKeyByHash(ctx, keyHash)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return u.APIKey{}, u.ErrAPIKeyInvalid
+			}
+
+			return u.APIKey{}, err
+		}
+
+		return toAPIKey(apiKey), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// This is checked after the cache, since a cached key can expire before it's evicted.
+	if apiKey.ExpiresAt != nil && time.Now().Unix() >= *apiKey.ExpiresAt {
+		return nil, u.ErrAPIKeyExpired
+	}
+
+	return &apiKey, nil
+}
+
+func (uc *AuthUsecase) CreateAPIKey(ctx context.Context, opts u.CreateAPIKeyOpts) (*u.CreatedAPIKey, error) {
+	if len(opts.Scopes) == 0 {
+		return nil, u.ErrAPIKeyInvalidScope
+	}
+
+	for _, scope := range opts.Scopes {
+		if !slices.Contains(u.APIKeyScopes, scope) {
+			return nil, u.ErrAPIKeyInvalidScope
+		}
+	}
+
+	keyId, err := randomHex(8)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := randomHex(32)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := uc.q.CreateAPIKey(ctx, db.CreateAPIKeyParams{
+		KeyID:     keyId,
+		KeyHash:   hashAPIKey(secret),
+		Name:      opts.Name,
+		Scopes:    opts.Scopes,
+		ExpiresAt: sqlx.Int32(opts.ExpiresAt),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &u.CreatedAPIKey{
+		APIKey: toAPIKey(apiKey),
+		Key:    secret,
+	}, nil
+}
+
+func (uc *AuthUsecase) GetAPIKeys(ctx context.Context) ([]u.APIKey, error) {
+	keys, err := uc.q.GetAPIKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys := make([]u.APIKey, 0)
+	for _, key := range keys {
+		apiKeys = append(apiKeys, toAPIKey(key))
+	}
+
+	return apiKeys, nil
+}
+
+func (uc *AuthUsecase) UpdateAPIKey(ctx context.Context, keyId string, opts u.UpdateAPIKeyOpts) (*u.APIKey, error) {
+	apiKey, err := uc.q.UpdateAPIKey(ctx, db.UpdateAPIKeyParams{
+		KeyID:     keyId,
+		Name:      sqlx.String(opts.Name),
+		ExpiresAt: sqlx.Int32(opts.ExpiresAt),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, u.ErrAPIKeyNotFound
+		}
+
+		return nil, err
+	}
+	uc.c.Invalidate(ctx, apiKeyCacheKey(apiKey.KeyHash))
+
+	result := toAPIKey(apiKey)
+	return &result, nil
+}
+
+func (uc *AuthUsecase) DeleteAPIKey(ctx context.Context, keyId string) error {
+	keyHash, err := uc.q.DeleteAPIKey(ctx, keyId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return u.ErrAPIKeyNotFound
+		}
+
+		return err
+	}
+	uc.c.Invalidate(ctx, apiKeyCacheKey(keyHash))
+
+	return nil
+}
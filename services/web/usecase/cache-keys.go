@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/typical-developers/discord-bot-backend/internal/cachekeys"
+	"github.com/typical-developers/discord-bot-backend/pkg/renderer"
+)
+
+// How long a leaderboard page is cached for.
+// Points are granted constantly, so pages are only cached briefly instead of being invalidated on every grant.
+const leaderboardCacheTTL = time.Second * 30
+
+// How long rendered card images are cached for.
+// The key includes a hash of the card's props, so a cached render never goes stale, it just stops being requested.
+const cardRenderCacheTTL = time.Hour
+
+// How long an API key is cached for after it's used.
+// Keys are invalidated when they're updated or deleted, this only bounds how long a missed invalidation lasts.
+const apiKeyCacheTTL = time.Second * 30
+
+// API keys are cached by the hash of the key, since that's all that's known when authenticating.
+func apiKeyCacheKey(keyHash string) string {
+	return fmt.Sprintf("api-key:%s", keyHash)
+}
+
+func guildSettingsCacheKey(guildId string) string {
+	return cachekeys.GuildSettings(guildId)
+}
+
+func activityRolesCacheKey(guildId string, activityType string) string {
+	return fmt.Sprintf("activity-roles:%s:%s", guildId, activityType)
+}
+
+func leaderboardCacheKey(guildId string, activityType, timePeriod string, periodStart int32, page int) string {
+	return fmt.Sprintf("leaderboard:%s:%s:%s:%d:%d", guildId, activityType, timePeriod, periodStart, page)
+}
+
+func cardDataVersionKey(guildId string) string {
+	return cachekeys.CardDataVersion(guildId)
+}
+
+// The subject is whatever identifies the card within the guild, i.e. the member or the leaderboard page.
+func renderedCardCacheKey(card string, guildId string, subject string, format string, version int64) string {
+	return fmt.Sprintf("rendered-card:%s:%s:%s:%s:%d", card, guildId, subject, format, version)
+}
+
+func cardRenderCacheKey(card string, format renderer.Format, propsHash string) string {
+	return fmt.Sprintf("card-render:%s:%s:%s", card, format, propsHash)
+}
+
+func chatActivitySettingsCacheKey(guildId string) string {
+	return fmt.Sprintf("chat-activity-settings:%s", guildId)
+}
+
+func memberProfileExistsCacheKey(guildId string, userId string) string {
+	return fmt.Sprintf("member-profile-exists:%s:%s", guildId, userId)
+}
+
+// The cooldown key isn't read through the cache, it only exists while the member is on cooldown.
+func chatGrantCooldownKey(guildId string, userId string) string {
+	return fmt.Sprintf("chat-grant-cooldown:%s:%s", guildId, userId)
+}
@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/typical-developers/discord-bot-backend/internal/metrics"
+	"github.com/typical-developers/discord-bot-backend/internal/tracing"
+	u "github.com/typical-developers/discord-bot-backend/internal/usecase"
+	"github.com/typical-developers/discord-bot-backend/pkg/bufferpool"
+	db_cache "github.com/typical-developers/discord-bot-backend/pkg/db-cache"
+	"github.com/typical-developers/discord-bot-backend/pkg/renderer"
+	"go.opentelemetry.io/otel/attribute"
+	"maragu.dev/gomponents"
+)
+
+const (
+	cardFormatHTML      = "html"
+	cardHTMLContentType = "text/html; charset=utf-8"
+)
+
+// parseCardFormat checks that cards can be rendered in the format before anything is fetched for them.
+// HTML is always available and is returned as an empty image format, images need a renderer.
+func parseCardFormat(r renderer.Renderer, format string) (renderer.Format, error) {
+	if format == cardFormatHTML {
+		return "", nil
+	}
+
+	if r == nil {
+		return "", u.ErrCardRenderingDisabled
+	}
+
+	imageFormat, err := renderer.ParseFormat(format)
+	if err != nil {
+		return "", u.ErrInvalidCardFormat
+	}
+
+	return imageFormat, nil
+}
+
+// bumpCardDataVersion marks the guilds' cards as changed, so their cached renders and ETags are no longer used.
+// This is done whenever activity points are granted or the guild's settings change.
+func bumpCardDataVersion(ctx context.Context, c *db_cache.Cache, guildIds ...string) {
+	keys := make([]string, 0, len(guildIds))
+	for _, guildId := range guildIds {
+		keys = append(keys, cardDataVersionKey(guildId))
+	}
+
+	c.BumpVersion(ctx, keys...)
+}
+
+// cardETag gets the ETag for a rendered card from the key it's cached under, which includes the guild's data version.
+// The ETag is weak, since the same version can be rendered with different Discord usernames and avatars once the render expires.
+func cardETag(cacheKey string) string {
+	hash := sha256.Sum256([]byte(cacheKey))
+	return fmt.Sprintf(`W/"%s"`, hex.EncodeToString(hash[:16]))
+}
+
+// versionedCardETag gets the card's ETag for the guild's current data version.
+// There's no ETag when the version can't be read, since there'd be no way to tell when the card changes.
+func versionedCardETag(ctx context.Context, c *db_cache.Cache, guildId string, cacheKey func(version int64) string) string {
+	version, ok := c.Version(ctx, cardDataVersionKey(guildId))
+	if !ok {
+		return ""
+	}
+
+	return cardETag(cacheKey(version))
+}
+
+// getVersionedCard reads the card from the cache for the guild's current data version, falling back to render.
+// Renders aren't cached when the version can't be read.
+func getVersionedCard[T any](ctx context.Context, c *db_cache.Cache, guildId string, cacheKey func(version int64) string, render func(ctx context.Context) (T, error)) (T, error) {
+	version, ok := c.Version(ctx, cardDataVersionKey(guildId))
+	if !ok {
+		return render(ctx)
+	}
+
+	return db_cache.Get(ctx, c, cacheKey(version), render)
+}
+
+// renderCardHTML renders the card's node to HTML.
+func renderCardHTML(ctx context.Context, card string, node gomponents.Node) ([]byte, error) {
+	html := bufferpool.Buffers.Get()
+	defer bufferpool.Buffers.Put(html)
+
+	_, span := tracing.Start(ctx, "render card html", attribute.String("card", card))
+	renderStart := time.Now()
+	if err := node.Render(html); err != nil {
+		tracing.End(span, err)
+		return nil, err
+	}
+	metrics.ObserveRender(card, renderStart)
+	span.End()
+
+	return bytes.Clone(html.Bytes()), nil
+}
+
+// renderCard renders the card as HTML, or as an image when an image format is given.
+//
+// Images are also cached by a hash of the card's props, so the card is only rendered again when something on it has changed.
+// The node and page are only built when the image isn't cached.
+func renderCard(ctx context.Context, r renderer.Renderer, c *db_cache.Cache, card string, imageFormat renderer.Format, props any, build func() (gomponents.Node, renderer.Page)) (*u.RenderedCard, error) {
+	if imageFormat == "" {
+		node, _ := build()
+
+		html, err := renderCardHTML(ctx, card, node)
+		if err != nil {
+			return nil, err
+		}
+
+		return &u.RenderedCard{
+			ContentType: cardHTMLContentType,
+			Data:        html,
+		}, nil
+	}
+
+	encodedProps, err := json.Marshal(props)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(encodedProps)
+
+	return db_cache.GetWithTTL(ctx, c, cardRenderCacheKey(card, imageFormat, hex.EncodeToString(hash[:])), cardRenderCacheTTL, func(ctx context.Context) (*u.RenderedCard, error) {
+		node, page := build()
+
+		html, err := renderCardHTML(ctx, card, node)
+		if err != nil {
+			return nil, err
+		}
+		page.HTML = html
+
+		ctx, span := tracing.Start(ctx, "render card image",
+			attribute.String("card", card),
+			attribute.String("format", string(imageFormat)),
+		)
+		renderStart := time.Now()
+		image, err := r.Render(ctx, page, imageFormat)
+		if err != nil {
+			tracing.End(span, err)
+
+			if errors.Is(err, renderer.ErrUnsupportedFormat) {
+				return nil, u.ErrUnsupportedCardFormat
+			}
+
+			return nil, err
+		}
+		metrics.ObserveRender(card+"_"+string(imageFormat), renderStart)
+		span.End()
+
+		return &u.RenderedCard{
+			ContentType: imageFormat.ContentType(),
+			Data:        image,
+		}, nil
+	})
+}
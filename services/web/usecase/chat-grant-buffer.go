@@ -0,0 +1,278 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/typical-developers/discord-bot-backend/internal/db"
+)
+
+type chatGrantKey struct {
+	guildId string
+	userId  string
+}
+
+// How many flushes a member's grants can fail in before they're dropped, so a grant that can never be applied doesn't stay in the buffer forever.
+const maxChatGrantAttempts = 5
+
+type chatGrant struct {
+	points    int32
+	grantedAt int32
+
+	// The points earned in each channel, so the ledger can still attribute them to the channels.
+	channels map[string]int32
+
+	// How many flushes the grant has failed in.
+	attempts int
+}
+
+// ChatGrantBuffer collects chat activity grants in memory and applies them to the database in batches.
+// Grants for the same member are aggregated, so each member is only written once per flush.
+type ChatGrantBuffer struct {
+	db *sql.DB
+	q  db.TxQuerier
+
+	interval time.Duration
+
+	mu     sync.Mutex
+	grants map[chatGrantKey]chatGrant
+
+	// Called with the members whose grants were applied, this is used to assign their activity roles.
+	onFlushed func(ctx context.Context, members []chatGrantKey)
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewChatGrantBuffer starts a buffer that flushes the grants at the given interval.
+// Close needs to be called on shutdown, otherwise any grants that haven't been flushed are lost.
+func NewChatGrantBuffer(db *sql.DB, q db.TxQuerier, interval time.Duration) *ChatGrantBuffer {
+	if interval <= 0 {
+		interval = time.Second * 5
+	}
+
+	b := &ChatGrantBuffer{
+		db:       db,
+		q:        q,
+		interval: interval,
+		grants:   make(map[chatGrantKey]chatGrant),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+
+	go b.run()
+
+	return b
+}
+
+// Add queues the points to be granted to the member on the next flush.
+func (b *ChatGrantBuffer) Add(guildId string, userId string, channelId string, points int32, grantedAt time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.merge(chatGrantKey{guildId: guildId, userId: userId}, chatGrant{
+		points:    points,
+		grantedAt: int32(grantedAt.Unix()),
+		channels:  map[string]int32{channelId: points},
+	})
+}
+
+// merge needs to be called while holding the lock.
+func (b *ChatGrantBuffer) merge(key chatGrantKey, grant chatGrant) {
+	existing := b.grants[key]
+	existing.points += grant.points
+	existing.grantedAt = max(existing.grantedAt, grant.grantedAt)
+	existing.attempts = max(existing.attempts, grant.attempts)
+
+	if existing.channels == nil {
+		existing.channels = make(map[string]int32, len(grant.channels))
+	}
+	for channelId, points := range grant.channels {
+		existing.channels[channelId] += points
+	}
+
+	b.grants[key] = existing
+}
+
+func (b *ChatGrantBuffer) run() {
+	defer close(b.done)
+
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), b.interval*2)
+			if err := b.Flush(ctx); err != nil {
+				log.WithError(err).Error("Failed to flush the buffered chat activity grants together, each member's grants were retried on their own.")
+			}
+			cancel()
+		case <-b.stop:
+			return
+		}
+	}
+}
+
+// Flush applies every buffered grant in a single transaction.
+//
+// When the transaction fails, each member's grants are applied on their own so a single bad grant can't hold back everyone else's.
+// The ones that still fail are put back into the buffer to be retried, until they've failed maxChatGrantAttempts times and are dropped.
+func (b *ChatGrantBuffer) Flush(ctx context.Context) error {
+	b.mu.Lock()
+	grants := b.grants
+	b.grants = make(map[chatGrantKey]chatGrant)
+	b.mu.Unlock()
+
+	if len(grants) == 0 {
+		return nil
+	}
+
+	err := b.apply(ctx, grants)
+
+	flushed := make([]chatGrantKey, 0, len(grants))
+	if err == nil {
+		for key := range grants {
+			flushed = append(flushed, key)
+		}
+	} else {
+		flushed = b.applyEach(ctx, grants)
+	}
+
+	log.WithField("members", len(flushed)).Debug("Flushed the buffered chat activity grants.")
+
+	if b.onFlushed != nil && len(flushed) > 0 {
+		b.onFlushed(ctx, flushed)
+	}
+
+	return err
+}
+
+// applyEach applies each member's grants in their own transaction, returning the members that were applied.
+// The grants that fail are put back into the buffer, unless they've run out of attempts.
+func (b *ChatGrantBuffer) applyEach(ctx context.Context, grants map[chatGrantKey]chatGrant) []chatGrantKey {
+	flushed := make([]chatGrantKey, 0)
+	failed := make(map[chatGrantKey]chatGrant)
+
+	// The grants aren't at fault when the database can't be reached, so it isn't counted against them.
+	available := b.db.PingContext(ctx) == nil
+
+	for key, grant := range grants {
+		// When the flush runs out of time, the rest are also retried without counting it against them.
+		if !available || ctx.Err() != nil {
+			failed[key] = grant
+			continue
+		}
+
+		err := b.apply(ctx, map[chatGrantKey]chatGrant{key: grant})
+		if err == nil {
+			flushed = append(flushed, key)
+			continue
+		}
+
+		logger := log.WithContext(ctx).WithFields(log.Fields{
+			"guild_id":  key.guildId,
+			"member_id": key.userId,
+			"points":    grant.points,
+			"attempts":  grant.attempts + 1,
+		}).WithError(err)
+
+		grant.attempts++
+		if grant.attempts >= maxChatGrantAttempts {
+			logger.Error("Dropped buffered chat activity grants that failed too many times.")
+			continue
+		}
+
+		logger.Warn("Failed to apply buffered chat activity grants, they will be retried on the next flush.")
+		failed[key] = grant
+	}
+
+	b.mu.Lock()
+	for key, grant := range failed {
+		b.merge(key, grant)
+	}
+	b.mu.Unlock()
+
+	return flushed
+}
+
+func (b *ChatGrantBuffer) apply(ctx context.Context, grants map[chatGrantKey]chatGrant) error {
+	guildIds := make([]string, 0, len(grants))
+	memberIds := make([]string, 0, len(grants))
+	points := make([]int32, 0, len(grants))
+	grantedAt := make([]int32, 0, len(grants))
+
+	// The ledger has an entry for each channel the member earned points in.
+	var ledger db.BulkInsertChatActivityLedgerEntriesParams
+	for key, grant := range grants {
+		guildIds = append(guildIds, key.guildId)
+		memberIds = append(memberIds, key.userId)
+		points = append(points, grant.points)
+		grantedAt = append(grantedAt, grant.grantedAt)
+
+		for channelId, channelPoints := range grant.channels {
+			ledger.GuildIds = append(ledger.GuildIds, key.guildId)
+			ledger.MemberIds = append(ledger.MemberIds, key.userId)
+			ledger.Points = append(ledger.Points, channelPoints)
+			ledger.GrantedAt = append(ledger.GrantedAt, grant.grantedAt)
+			ledger.ChannelIds = append(ledger.ChannelIds, channelId)
+		}
+	}
+
+	tx, err := b.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	q := b.q.InTx(tx)
+
+	err = q.BulkIncrementMemberChatActivityPoints(ctx, db.BulkIncrementMemberChatActivityPointsParams{
+		GuildIds:  guildIds,
+		MemberIds: memberIds,
+		Points:    points,
+		GrantedAt: grantedAt,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = q.BulkIncrementWeeklyActivityLeaderboard(ctx, db.BulkIncrementWeeklyActivityLeaderboardParams{
+		GrantType:    "chat",
+		GuildIds:     guildIds,
+		MemberIds:    memberIds,
+		EarnedPoints: points,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = q.BulkIncrementMonthlyActivityLeaderboard(ctx, db.BulkIncrementMonthlyActivityLeaderboardParams{
+		GrantType:    "chat",
+		GuildIds:     guildIds,
+		MemberIds:    memberIds,
+		EarnedPoints: points,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := q.BulkInsertChatActivityLedgerEntries(ctx, ledger); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Close stops the periodic flushes and flushes whatever is left in the buffer.
+func (b *ChatGrantBuffer) Close(ctx context.Context) error {
+	close(b.stop)
+	<-b.done
+
+	return b.Flush(ctx)
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+)
+
+func TestChatGrantBufferMergesChannels(t *testing.T) {
+	b := &ChatGrantBuffer{grants: make(map[chatGrantKey]chatGrant)}
+	now := time.Unix(1_700_000_000, 0)
+
+	b.Add("guild", "member", "general", 10, now)
+	b.Add("guild", "member", "general", 5, now.Add(time.Second))
+	b.Add("guild", "member", "memes", 3, now.Add(2*time.Second))
+
+	grant := b.grants[chatGrantKey{guildId: "guild", userId: "member"}]
+	if grant.points != 18 {
+		t.Errorf("expected 18 points in total, got %d", grant.points)
+	}
+
+	if grant.grantedAt != int32(now.Add(2*time.Second).Unix()) {
+		t.Errorf("expected the latest grant time, got %d", grant.grantedAt)
+	}
+
+	if grant.channels["general"] != 15 || grant.channels["memes"] != 3 || len(grant.channels) != 2 {
+		t.Errorf("expected 15 points in general and 3 in memes, got %v", grant.channels)
+	}
+}
+
+func TestChatGrantBufferKeepsAttempts(t *testing.T) {
+	b := &ChatGrantBuffer{grants: make(map[chatGrantKey]chatGrant)}
+	key := chatGrantKey{guildId: "guild", userId: "member"}
+
+	b.merge(key, chatGrant{points: 4, channels: map[string]int32{"general": 4}, attempts: 2})
+	b.Add("guild", "member", "general", 1, time.Now())
+
+	grant := b.grants[key]
+	if grant.attempts != 2 {
+		t.Errorf("expected the failed attempts to be kept when new grants are added, got %d", grant.attempts)
+	}
+
+	if grant.channels["general"] != 5 {
+		t.Errorf("expected 5 points in general, got %d", grant.channels["general"])
+	}
+}
@@ -2,29 +2,54 @@ package usecase
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"time"
 
 	"github.com/lib/pq"
 	"github.com/typical-developers/discord-bot-backend/internal/db"
+	"github.com/typical-developers/discord-bot-backend/internal/metrics"
 	"github.com/typical-developers/discord-bot-backend/internal/pages/layouts"
 	"github.com/typical-developers/discord-bot-backend/pkg/bufferpool"
+	db_cache "github.com/typical-developers/discord-bot-backend/pkg/db-cache"
 	discord_state "github.com/typical-developers/discord-bot-backend/pkg/discord-state"
+	"github.com/typical-developers/discord-bot-backend/pkg/renderer"
 	"github.com/typical-developers/discord-bot-backend/pkg/sqlx"
 	"maragu.dev/gomponents"
 
+	"github.com/typical-developers/discord-bot-backend/internal/tracing"
 	u "github.com/typical-developers/discord-bot-backend/internal/usecase"
 )
 
 type GuildUsecase struct {
 	db *sql.DB
-	q  *db.Queries
+	q  db.TxQuerier
 	d  *discord_state.StateManager
+	c  *db_cache.Cache
+
+	// When set, leaderboard cards can be rendered to images.
+	r renderer.Renderer
+}
+
+func NewGuildUsecase(db *sql.DB, q db.TxQuerier, d *discord_state.StateManager, c *db_cache.Cache, r renderer.Renderer) u.GuildsUsecase {
+	return &GuildUsecase{db: db, q: q, d: d, c: c, r: r}
 }
 
-func NewGuildUsecase(db *sql.DB, q *db.Queries, d *discord_state.StateManager) u.GuildsUsecase {
-	return &GuildUsecase{db: db, q: q, d: d}
+// invalidateGuildSettings removes the guild's cached settings, which also includes its activity roles and the chat activity settings used for buffered grants.
+// The guild's cards are also marked as changed, since they show its activity roles.
+func (uc *GuildUsecase) invalidateGuildSettings(ctx context.Context, guildId string) {
+	uc.c.Invalidate(ctx,
+		guildSettingsCacheKey(guildId),
+		activityRolesCacheKey(guildId, "chat"),
+		activityRolesCacheKey(guildId, "voice"),
+		chatActivitySettingsCacheKey(guildId),
+	)
+	bumpCardDataVersion(ctx, uc.c, guildId)
 }
 
 func (uc *GuildUsecase) RegisterGuild(ctx context.Context, guildId string) (*u.GuildSettings, error) {
@@ -42,7 +67,15 @@ func (uc *GuildUsecase) RegisterGuild(ctx context.Context, guildId string) (*u.G
 }
 
 func (uc *GuildUsecase) GetGuildSettings(ctx context.Context, guildId string) (*u.GuildSettings, error) {
-	chatActivitySettings, err := uc.q.GetGuildChatActivitySettings(ctx, guildId)
+	return db_cache.Get(ctx, uc.c, guildSettingsCacheKey(guildId), func(ctx context.Context) (*u.GuildSettings, error) {
+		return uc.getGuildSettings(ctx, uc.q, guildId)
+	})
+}
+
+// getGuildSettings reads the guild's settings from the database without going through the cache.
+// Updates pass their transaction's querier so the audit log compares against what the update actually changed.
+func (uc *GuildUsecase) getGuildSettings(ctx context.Context, q db.Querier, guildId string) (*u.GuildSettings, error) {
+	chatActivitySettings, err := q.GetGuildChatActivitySettings(ctx, guildId)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, u.ErrGuildNotFound
@@ -51,10 +84,12 @@ func (uc *GuildUsecase) GetGuildSettings(ctx context.Context, guildId string) (*
 		return nil, err
 	}
 
-	chatActivityRoles, err := uc.q.GetGuildActivityRoles(ctx, db.GetGuildActivityRolesParams{
-		GuildID:      guildId,
-		ActivityType: "chat",
-	})
+	chatRoles, err := uc.getActivityRoles(ctx, q, guildId, "chat")
+	if err != nil {
+		return nil, err
+	}
+
+	voiceActivitySettings, err := q.GetGuildVoiceActivitySettings(ctx, guildId)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, u.ErrGuildNotFound
@@ -63,15 +98,12 @@ func (uc *GuildUsecase) GetGuildSettings(ctx context.Context, guildId string) (*
 		return nil, err
 	}
 
-	chatRoles := make([]u.GuildActivityRole, 0)
-	for _, role := range chatActivityRoles {
-		chatRoles = append(chatRoles, u.GuildActivityRole{
-			RoleID:         role.RoleID,
-			RequiredPoints: role.RequiredPoints.Int32,
-		})
+	voiceRoles, err := uc.getActivityRoles(ctx, q, guildId, "voice")
+	if err != nil {
+		return nil, err
 	}
 
-	creationLobbies, err := uc.q.GetVoiceRoomLobbies(ctx, guildId)
+	creationLobbies, err := q.GetVoiceRoomLobbies(ctx, guildId)
 	if err != nil {
 		return nil, err
 	}
@@ -89,7 +121,22 @@ func (uc *GuildUsecase) GetGuildSettings(ctx context.Context, guildId string) (*
 		})
 	}
 
-	messageEmbeds, err := uc.q.GetGuildMessageEmbedSettings(ctx, guildId)
+	messageEmbeds, err := q.GetGuildMessageEmbedSettings(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	channelMultipliers, err := uc.chatActivityChannelMultipliers(ctx, q, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	activityBoosts, err := uc.activityBoosts(ctx, q, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	auditLogChannelId, err := q.GetGuildAuditLogChannel(ctx, guildId)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +147,24 @@ func (uc *GuildUsecase) GetGuildSettings(ctx context.Context, guildId string) (*
 			CooldownSeconds: chatActivitySettings.GrantCooldown,
 			GrantAmount:     chatActivitySettings.GrantAmount,
 			ActivityRoles:   chatRoles,
-			DenyRoles:       []string{},
+			DenyRoles:       chatActivitySettings.DenyRoles,
+
+			AutoAssignRoles:    chatActivitySettings.AutoAssignRoles,
+			RoleAssignmentMode: chatActivitySettings.RoleAssignmentMode,
+			AutoCreateProfiles: chatActivitySettings.AutoCreateProfiles,
+
+			ChannelMultipliers: channelMultipliers,
+		},
+		VoiceActivityTracking: u.GuildActivityTracking{
+			IsEnabled:       voiceActivitySettings.IsEnabled,
+			CooldownSeconds: voiceActivitySettings.GrantCooldown,
+			GrantAmount:     voiceActivitySettings.GrantAmount,
+			ActivityRoles:   voiceRoles,
+			DenyRoles:       voiceActivitySettings.DenyRoles,
+
+			AutoAssignRoles:    voiceActivitySettings.AutoAssignRoles,
+			RoleAssignmentMode: voiceActivitySettings.RoleAssignmentMode,
+			AutoCreateProfiles: voiceActivitySettings.AutoCreateProfiles,
 		},
 
 		MessageEmbeds: u.MessageEmbeds{
@@ -111,19 +175,40 @@ func (uc *GuildUsecase) GetGuildSettings(ctx context.Context, guildId string) (*
 		},
 
 		VoiceRoomLobbies: lobbies,
+		ActivityBoosts:   activityBoosts,
+
+		AuditLogChannelID: auditLogChannelId,
 	}, nil
 }
 
 func (uc *GuildUsecase) UpdateGuildActivitySettings(ctx context.Context, guildId string, opts u.UpdateAcitivtySettings) (*u.GuildSettings, error) {
+	tx, err := uc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	q := uc.q.InTx(tx)
+
+	before, err := uc.getGuildSettings(ctx, q, guildId)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
 	if opts.ChatActivity != nil {
-		err := uc.q.UpdateGuildChatActivitySettings(ctx, db.UpdateGuildChatActivitySettingsParams{
+		err := q.UpdateGuildChatActivitySettings(ctx, db.UpdateGuildChatActivitySettingsParams{
 			GuildID:       guildId,
 			IsEnabled:     sqlx.Bool(opts.ChatActivity.IsEnabled),
 			GrantAmount:   sqlx.Int32(opts.ChatActivity.GrantAmount),
 			GrantCooldown: sqlx.Int32(opts.ChatActivity.CooldownSeconds),
+
+			AutoAssignRoles:    sqlx.Bool(opts.ChatActivity.AutoAssignRoles),
+			RoleAssignmentMode: sqlx.String(opts.ChatActivity.RoleAssignmentMode),
+			AutoCreateProfiles: sqlx.Bool(opts.ChatActivity.AutoCreateProfiles),
 		})
 
 		if err != nil {
+			_ = tx.Rollback()
+
 			if errors.Is(err, sql.ErrNoRows) {
 				return nil, u.ErrGuildNotFound
 			}
@@ -132,11 +217,141 @@ func (uc *GuildUsecase) UpdateGuildActivitySettings(ctx context.Context, guildId
 		}
 	}
 
-	return uc.GetGuildSettings(ctx, guildId)
+	if opts.VoiceActivity != nil {
+		err := q.UpdateGuildVoiceActivitySettings(ctx, db.UpdateGuildVoiceActivitySettingsParams{
+			GuildID:       guildId,
+			IsEnabled:     sqlx.Bool(opts.VoiceActivity.IsEnabled),
+			GrantAmount:   sqlx.Int32(opts.VoiceActivity.GrantAmount),
+			GrantCooldown: sqlx.Int32(opts.VoiceActivity.CooldownSeconds),
+
+			AutoAssignRoles:    sqlx.Bool(opts.VoiceActivity.AutoAssignRoles),
+			RoleAssignmentMode: sqlx.String(opts.VoiceActivity.RoleAssignmentMode),
+			AutoCreateProfiles: sqlx.Bool(opts.VoiceActivity.AutoCreateProfiles),
+		})
+
+		if err != nil {
+			_ = tx.Rollback()
+
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, u.ErrGuildNotFound
+			}
+
+			return nil, err
+		}
+	}
+
+	settings, err := uc.getGuildSettings(ctx, q, guildId)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	uc.invalidateGuildSettings(ctx, guildId)
+
+	if opts.ChatActivity != nil {
+		uc.recordSettingsChange(ctx, guildId, "activity_settings.update", "chat", before.ChatActivityTracking, settings.ChatActivityTracking)
+	}
+
+	if opts.VoiceActivity != nil {
+		uc.recordSettingsChange(ctx, guildId, "activity_settings.update", "voice", before.VoiceActivityTracking, settings.VoiceActivityTracking)
+	}
+
+	return settings, nil
+}
+
+func (uc *GuildUsecase) GetActivityRoles(ctx context.Context, guildId string, activityType string) ([]u.GuildActivityRole, error) {
+	if activityType != "chat" && activityType != "voice" {
+		return nil, u.ErrInvalidActivityType
+	}
+
+	return db_cache.Get(ctx, uc.c, activityRolesCacheKey(guildId, activityType), func(ctx context.Context) ([]u.GuildActivityRole, error) {
+		return uc.getActivityRoles(ctx, uc.q, guildId, activityType)
+	})
+}
+
+func (uc *GuildUsecase) getActivityRoles(ctx context.Context, q db.Querier, guildId string, activityType string) ([]u.GuildActivityRole, error) {
+	activityRoles, err := q.GetGuildActivityRoles(ctx, db.GetGuildActivityRolesParams{
+		GuildID:      guildId,
+		ActivityType: activityType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]u.GuildActivityRole, 0)
+	for _, role := range activityRoles {
+		roles = append(roles, u.GuildActivityRole{
+			RoleID:         role.RoleID,
+			ActivityType:   activityType,
+			RequiredPoints: role.RequiredPoints.Int32,
+		})
+	}
+
+	return roles, nil
+}
+
+func (uc *GuildUsecase) GetActivityRole(ctx context.Context, guildId string, roleId string) (*u.GuildActivityRole, error) {
+	role, err := uc.q.GetActivityRole(ctx, db.GetActivityRoleParams{
+		GuildID: guildId,
+		RoleID:  roleId,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, u.ErrActivityRoleNotFound
+		}
+
+		return nil, err
+	}
+
+	return &u.GuildActivityRole{
+		RoleID:         role.RoleID,
+		ActivityType:   role.GrantType,
+		RequiredPoints: role.RequiredPoints.Int32,
+	}, nil
+}
+
+// checkActivityRoleThreshold makes sure no other activity role of the same type is earned at the same amount of points.
+func (uc *GuildUsecase) checkActivityRoleThreshold(ctx context.Context, guildId string, activityType string, roleId string, requiredPoints int32) error {
+	exists, err := uc.q.ActivityRoleThresholdExists(ctx, db.ActivityRoleThresholdExistsParams{
+		GuildID:        guildId,
+		GrantType:      activityType,
+		RequiredPoints: requiredPoints,
+		RoleID:         roleId,
+	})
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return u.ErrActivityRoleDuplicateThreshold
+	}
+
+	return nil
 }
 
 func (uc *GuildUsecase) CreateActivityRole(ctx context.Context, guildId string, activityType string, roleId string, requiredPoints int32) (*u.GuildActivityRole, error) {
-	err := uc.q.InsertActivityRole(ctx, db.InsertActivityRoleParams{
+	if activityType != "chat" && activityType != "voice" {
+		return nil, u.ErrInvalidActivityType
+	}
+
+	_, err := uc.d.GuildRole(ctx, guildId, roleId)
+	if err != nil {
+		if errors.Is(err, discord_state.ErrRoleNotFound) {
+			return nil, u.ErrGuildRoleNotFound
+		}
+
+		return nil, err
+	}
+
+	if err := uc.checkActivityRoleThreshold(ctx, guildId, activityType, roleId, requiredPoints); err != nil {
+		return nil, err
+	}
+
+	err = uc.q.InsertActivityRole(ctx, db.InsertActivityRoleParams{
 		GuildID:        guildId,
 		GrantType:      activityType,
 		RoleID:         roleId,
@@ -145,18 +360,88 @@ func (uc *GuildUsecase) CreateActivityRole(ctx context.Context, guildId string,
 
 	if err != nil {
 		var pqErr *pq.Error
-		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
-			return nil, u.ErrActivityRoleExists
+		if errors.As(err, &pqErr) {
+			switch pqErr.Code {
+			case "23505":
+				return nil, u.ErrActivityRoleExists
+			case "23503":
+				return nil, u.ErrGuildNotFound
+			}
 		}
 
 		return nil, err
 	}
 
-	return nil, nil
+	uc.invalidateGuildSettings(ctx, guildId)
+
+	role := &u.GuildActivityRole{
+		RoleID:         roleId,
+		ActivityType:   activityType,
+		RequiredPoints: requiredPoints,
+	}
+	uc.recordSettingsChange(ctx, guildId, "activity_role.create", roleId, nil, role)
+
+	return role, nil
+}
+
+func (uc *GuildUsecase) UpdateActivityRole(ctx context.Context, guildId string, roleId string, opts u.UpdateActivityRoleOpts) (*u.GuildActivityRole, error) {
+	if opts.ActivityType != nil && *opts.ActivityType != "chat" && *opts.ActivityType != "voice" {
+		return nil, u.ErrInvalidActivityType
+	}
+
+	current, err := uc.GetActivityRole(ctx, guildId, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	activityType := current.ActivityType
+	if opts.ActivityType != nil {
+		activityType = *opts.ActivityType
+	}
+
+	requiredPoints := current.RequiredPoints
+	if opts.RequiredPoints != nil {
+		requiredPoints = *opts.RequiredPoints
+	}
+
+	if err := uc.checkActivityRoleThreshold(ctx, guildId, activityType, roleId, requiredPoints); err != nil {
+		return nil, err
+	}
+
+	role, err := uc.q.UpdateActivityRole(ctx, db.UpdateActivityRoleParams{
+		GuildID: guildId,
+		RoleID:  roleId,
+
+		GrantType:      sqlx.String(opts.ActivityType),
+		RequiredPoints: sqlx.Int32(opts.RequiredPoints),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, u.ErrActivityRoleNotFound
+		}
+
+		return nil, err
+	}
+
+	uc.invalidateGuildSettings(ctx, guildId)
+
+	updated := &u.GuildActivityRole{
+		RoleID:         role.RoleID,
+		ActivityType:   role.GrantType,
+		RequiredPoints: role.RequiredPoints.Int32,
+	}
+	uc.recordSettingsChange(ctx, guildId, "activity_role.update", roleId, current, updated)
+
+	return updated, nil
 }
 
 func (uc *GuildUsecase) DeleteActivityRole(ctx context.Context, guildId string, roleId string) error {
-	err := uc.q.DeleteActivityRole(ctx, db.DeleteActivityRoleParams{
+	role, err := uc.GetActivityRole(ctx, guildId, roleId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := uc.q.DeleteActivityRole(ctx, db.DeleteActivityRoleParams{
 		GuildID: guildId,
 		RoleID:  roleId,
 	})
@@ -165,6 +450,278 @@ func (uc *GuildUsecase) DeleteActivityRole(ctx context.Context, guildId string,
 		return err
 	}
 
+	if rows == 0 {
+		return u.ErrActivityRoleNotFound
+	}
+
+	uc.invalidateGuildSettings(ctx, guildId)
+	uc.recordSettingsChange(ctx, guildId, "activity_role.delete", roleId, role, nil)
+
+	return nil
+}
+
+func toActivityRoleResyncJob(job db.GuildActivityRoleResyncJob) *u.ActivityRoleResyncJob {
+	resync := &u.ActivityRoleResyncJob{
+		JobID:             job.JobID,
+		Status:            job.Status,
+		TotalProfiles:     job.TotalProfiles,
+		ProcessedProfiles: job.ProcessedProfiles,
+		FailedProfiles:    job.FailedProfiles,
+		RolesAdded:        job.RolesAdded,
+		RolesRemoved:      job.RolesRemoved,
+		Error:             job.Error,
+		CreatedAt:         int64(job.InsertEpoch),
+	}
+
+	if job.StartedAt.Valid {
+		startedAt := int64(job.StartedAt.Int32)
+		resync.StartedAt = &startedAt
+	}
+
+	if job.FinishedAt.Valid {
+		finishedAt := int64(job.FinishedAt.Int32)
+		resync.FinishedAt = &finishedAt
+	}
+
+	return resync
+}
+
+// EnqueueActivityRoleResync queues a job for the cron service to resync every member's activity roles.
+func (uc *GuildUsecase) EnqueueActivityRoleResync(ctx context.Context, guildId string) (*u.ActivityRoleResyncJob, error) {
+	job, err := uc.q.CreateActivityRoleResyncJob(ctx, guildId)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) {
+			switch pqErr.Code {
+			case "23503":
+				return nil, u.ErrGuildNotFound
+			case "23505":
+				return nil, u.ErrActivityRoleResyncJobExists
+			}
+		}
+
+		return nil, err
+	}
+
+	return toActivityRoleResyncJob(job), nil
+}
+
+func (uc *GuildUsecase) GetActivityRoleResyncJob(ctx context.Context, guildId string, jobId int32) (*u.ActivityRoleResyncJob, error) {
+	job, err := uc.q.GetActivityRoleResyncJob(ctx, db.GetActivityRoleResyncJobParams{
+		GuildID: guildId,
+		JobID:   jobId,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, u.ErrActivityRoleResyncJobNotFound
+		}
+
+		return nil, err
+	}
+
+	return toActivityRoleResyncJob(job), nil
+}
+
+func (uc *GuildUsecase) GetActivityDenyRoles(ctx context.Context, guildId string, activityType string) ([]string, error) {
+	var denyRoles []string
+	switch activityType {
+	case "chat":
+		settings, err := uc.q.GetGuildChatActivitySettings(ctx, guildId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, u.ErrGuildNotFound
+			}
+
+			return nil, err
+		}
+
+		denyRoles = settings.DenyRoles
+	case "voice":
+		settings, err := uc.q.GetGuildVoiceActivitySettings(ctx, guildId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, u.ErrGuildNotFound
+			}
+
+			return nil, err
+		}
+
+		denyRoles = settings.DenyRoles
+	default:
+		return nil, u.ErrInvalidActivityType
+	}
+
+	if denyRoles == nil {
+		denyRoles = []string{}
+	}
+
+	return denyRoles, nil
+}
+
+func (uc *GuildUsecase) AddActivityDenyRole(ctx context.Context, guildId string, activityType string, roleId string) ([]string, error) {
+	if activityType != "chat" && activityType != "voice" {
+		return nil, u.ErrInvalidActivityType
+	}
+
+	_, err := uc.d.GuildRole(ctx, guildId, roleId)
+	if err != nil {
+		if errors.Is(err, discord_state.ErrRoleNotFound) {
+			return nil, u.ErrGuildRoleNotFound
+		}
+
+		return nil, err
+	}
+
+	var denyRoles []string
+	params := db.AppendGuildChatActivityDenyRoleParams{
+		GuildID: guildId,
+		RoleID:  roleId,
+	}
+
+	switch activityType {
+	case "chat":
+		denyRoles, err = uc.q.AppendGuildChatActivityDenyRole(ctx, params)
+	case "voice":
+		denyRoles, err = uc.q.AppendGuildVoiceActivityDenyRole(ctx, db.AppendGuildVoiceActivityDenyRoleParams(params))
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, u.ErrGuildNotFound
+		}
+
+		return nil, err
+	}
+
+	uc.invalidateGuildSettings(ctx, guildId)
+	uc.recordSettingsChange(ctx, guildId, "activity_deny_role.add", roleId, nil, map[string]string{"activity_type": activityType})
+
+	return denyRoles, nil
+}
+
+func (uc *GuildUsecase) RemoveActivityDenyRole(ctx context.Context, guildId string, activityType string, roleId string) ([]string, error) {
+	denyRoles, err := uc.GetActivityDenyRoles(ctx, guildId, activityType)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(denyRoles, roleId) {
+		return nil, u.ErrActivityDenyRoleNotFound
+	}
+
+	params := db.RemoveGuildChatActivityDenyRoleParams{
+		GuildID: guildId,
+		RoleID:  roleId,
+	}
+
+	switch activityType {
+	case "chat":
+		denyRoles, err = uc.q.RemoveGuildChatActivityDenyRole(ctx, params)
+	case "voice":
+		denyRoles, err = uc.q.RemoveGuildVoiceActivityDenyRole(ctx, db.RemoveGuildVoiceActivityDenyRoleParams(params))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	uc.invalidateGuildSettings(ctx, guildId)
+	uc.recordSettingsChange(ctx, guildId, "activity_deny_role.remove", roleId, map[string]string{"activity_type": activityType}, nil)
+
+	return denyRoles, nil
+}
+
+func (uc *GuildUsecase) GetChatActivityChannelMultipliers(ctx context.Context, guildId string) ([]u.GuildActivityChannelMultiplier, error) {
+	return uc.chatActivityChannelMultipliers(ctx, uc.q, guildId)
+}
+
+func (uc *GuildUsecase) chatActivityChannelMultipliers(ctx context.Context, q db.Querier, guildId string) ([]u.GuildActivityChannelMultiplier, error) {
+	rows, err := q.GetChatActivityChannelMultipliers(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	multipliers := make([]u.GuildActivityChannelMultiplier, 0)
+	for _, row := range rows {
+		multipliers = append(multipliers, u.GuildActivityChannelMultiplier{
+			ChannelID:  row.ChannelID,
+			Multiplier: row.Multiplier,
+		})
+	}
+
+	return multipliers, nil
+}
+
+// chatActivityChannelMultiplier gets the channel's multiplier, this is nil when the channel doesn't have one.
+func (uc *GuildUsecase) chatActivityChannelMultiplier(ctx context.Context, guildId string, channelId string) (*u.GuildActivityChannelMultiplier, error) {
+	multipliers, err := uc.GetChatActivityChannelMultipliers(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, multiplier := range multipliers {
+		if multiplier.ChannelID == channelId {
+			return &multiplier, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (uc *GuildUsecase) SetChatActivityChannelMultiplier(ctx context.Context, guildId string, channelId string, multiplier float32) (*u.GuildActivityChannelMultiplier, error) {
+	previous, err := uc.chatActivityChannelMultiplier(ctx, guildId, channelId)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := uc.q.UpsertChatActivityChannelMultiplier(ctx, db.UpsertChatActivityChannelMultiplierParams{
+		GuildID:    guildId,
+		ChannelID:  channelId,
+		Multiplier: multiplier,
+	})
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+			return nil, u.ErrGuildNotFound
+		}
+
+		return nil, err
+	}
+
+	uc.invalidateGuildSettings(ctx, guildId)
+
+	updated := &u.GuildActivityChannelMultiplier{
+		ChannelID:  row.ChannelID,
+		Multiplier: row.Multiplier,
+	}
+	if previous == nil {
+		uc.recordSettingsChange(ctx, guildId, "channel_multiplier.create", channelId, nil, updated)
+	} else {
+		uc.recordSettingsChange(ctx, guildId, "channel_multiplier.update", channelId, previous, updated)
+	}
+
+	return updated, nil
+}
+
+func (uc *GuildUsecase) DeleteChatActivityChannelMultiplier(ctx context.Context, guildId string, channelId string) error {
+	previous, err := uc.chatActivityChannelMultiplier(ctx, guildId, channelId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := uc.q.DeleteChatActivityChannelMultiplier(ctx, db.DeleteChatActivityChannelMultiplierParams{
+		GuildID:   guildId,
+		ChannelID: channelId,
+	})
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return u.ErrChannelMultiplierNotFound
+	}
+
+	uc.invalidateGuildSettings(ctx, guildId)
+	uc.recordSettingsChange(ctx, guildId, "channel_multiplier.delete", channelId, previous, nil)
+
 	return nil
 }
 
@@ -173,7 +730,13 @@ func (uc *GuildUsecase) UpdateMessageEmbedSettings(ctx context.Context, guildId
 	if err != nil {
 		return nil, err
 	}
-	q := uc.q.WithTx(tx)
+	q := uc.q.InTx(tx)
+
+	before, err := uc.getGuildSettings(ctx, q, guildId)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
 
 	if opts.IsEnabled != nil {
 		err := q.UpdateGuildMessageEmbedSettings(ctx, db.UpdateGuildMessageEmbedSettingsParams{
@@ -232,14 +795,24 @@ func (uc *GuildUsecase) UpdateMessageEmbedSettings(ctx context.Context, guildId
 		}
 	}
 
+	settings, err := uc.getGuildSettings(ctx, q, guildId)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
-	return uc.GetGuildSettings(ctx, guildId)
+	uc.invalidateGuildSettings(ctx, guildId)
+	uc.recordSettingsChange(ctx, guildId, "message_embeds.update", "", before.MessageEmbeds, settings.MessageEmbeds)
+
+	return settings, nil
 }
 
-func (uc *GuildUsecase) GenerateGuildActivityLeaderboardCard(ctx context.Context, guildId string, acitivtyType, timePeriod string, page int) (gomponents.Node, error) {
+// leaderboardCardProps gets everything shown on the page of the guild's leaderboard card.
+func (uc *GuildUsecase) leaderboardCardProps(ctx context.Context, guildId string, acitivtyType, timePeriod string, page int) (*layouts.ServerLeaderboardProps, error) {
 	guild, err := uc.d.Guild(ctx, guildId)
 	if err != nil {
 		return nil, err
@@ -251,7 +824,7 @@ func (uc *GuildUsecase) GenerateGuildActivityLeaderboardCard(ctx context.Context
 	}
 
 	limitBy := int32(15)
-	var card gomponents.Node
+	var props layouts.ServerLeaderboardProps
 	switch timePeriod {
 	case "weekly":
 		leaderboard, err := uc.q.GetWeeklyActivityLeaderboard(ctx, db.GetWeeklyActivityLeaderboardParams{
@@ -293,13 +866,13 @@ func (uc *GuildUsecase) GenerateGuildActivityLeaderboardCard(ctx context.Context
 			})
 		}
 
-		card = layouts.ServerLeaderboard(layouts.ServerLeaderboardProps{
+		props = layouts.ServerLeaderboardProps{
 			ServerInfo: serverInfo,
 			LeaderboardInfo: layouts.LeaderboardInfo{
 				Name: "Activity Points - Weekly",
 				Data: fields,
 			},
-		})
+		}
 	case "monthly":
 		leaderboard, err := uc.q.GetMonthlyActivityLeaderboard(ctx, db.GetMonthlyActivityLeaderboardParams{
 			GuildID:   guildId,
@@ -340,13 +913,13 @@ func (uc *GuildUsecase) GenerateGuildActivityLeaderboardCard(ctx context.Context
 			})
 		}
 
-		card = layouts.ServerLeaderboard(layouts.ServerLeaderboardProps{
+		props = layouts.ServerLeaderboardProps{
 			ServerInfo: serverInfo,
 			LeaderboardInfo: layouts.LeaderboardInfo{
 				Name: "Activity Points - Monthly",
 				Data: fields,
 			},
-		})
+		}
 	default:
 		leaderboard, err := uc.q.GetAllTimeActivityLeaderboard(ctx, db.GetAllTimeActivityLeaderboardParams{
 			ActivityType: acitivtyType,
@@ -388,39 +961,216 @@ func (uc *GuildUsecase) GenerateGuildActivityLeaderboardCard(ctx context.Context
 			})
 		}
 
-		card = layouts.ServerLeaderboard(layouts.ServerLeaderboardProps{
+		props = layouts.ServerLeaderboardProps{
 			ServerInfo: serverInfo,
 			LeaderboardInfo: layouts.LeaderboardInfo{
 				Name: "Activity Points - All Time",
 				Data: fields,
 			},
-		})
+		}
 	}
 
-	return card, nil
+	return &props, nil
 }
 
-func (uc *GuildUsecase) GetGuildActivityLeaderboard(ctx context.Context, referer string, guildId string, activityType, timePeriod string, page int) (*u.GuildLeaderboard, error) {
-	guild, err := uc.d.Guild(ctx, guildId)
+// leaderboardPeriodKey identifies the period the leaderboard is showing.
+// This keeps cached cards for the current weekly or monthly period from being reused once the next one starts.
+func leaderboardPeriodKey(timePeriod string, archivedPeriod int32) int64 {
+	if archivedPeriod != 0 {
+		return int64(archivedPeriod)
+	}
+
+	if timePeriod != "weekly" && timePeriod != "monthly" {
+		return 0
+	}
+
+	return leaderboardPeriodStart(timePeriod, time.Now(), 0).Unix()
+}
+
+func leaderboardCardCacheKey(guildId string, activityType, timePeriod string, page int, format string) func(version int64) string {
+	subject := fmt.Sprintf("%s:%s:%d:%d", activityType, timePeriod, leaderboardPeriodKey(timePeriod, 0), page)
+
+	return func(version int64) string {
+		return renderedCardCacheKey("leaderboard", guildId, subject, format, version)
+	}
+}
+
+func (uc *GuildUsecase) GetGuildActivityLeaderboardCardETag(ctx context.Context, guildId string, activityType, timePeriod string, page int, format string) (string, error) {
+	if _, err := parseCardFormat(uc.r, format); err != nil {
+		return "", err
+	}
+
+	return versionedCardETag(ctx, uc.c, guildId, leaderboardCardCacheKey(guildId, activityType, timePeriod, page, format)), nil
+}
+
+func (uc *GuildUsecase) GenerateGuildActivityLeaderboardCard(ctx context.Context, guildId string, activityType, timePeriod string, page int, format string) (*u.RenderedCard, error) {
+	imageFormat, err := parseCardFormat(uc.r, format)
 	if err != nil {
 		return nil, err
 	}
 
-	serverInfo := layouts.ServerInfo{
-		Icon: guild.IconURL("100"),
-		Name: guild.Name,
+	return getVersionedCard(ctx, uc.c, guildId, leaderboardCardCacheKey(guildId, activityType, timePeriod, page, format), func(ctx context.Context) (*u.RenderedCard, error) {
+		props, err := uc.leaderboardCardProps(ctx, guildId, activityType, timePeriod, page)
+		if err != nil {
+			return nil, err
+		}
+
+		return renderCard(ctx, uc.r, uc.c, "leaderboard", imageFormat, props, func() (gomponents.Node, renderer.Page) {
+			lines := make([]string, 0, len(props.LeaderboardInfo.Data))
+			for _, field := range props.LeaderboardInfo.Data {
+				lines = append(lines, fmt.Sprintf("#%d  %s  %d", field.Rank, field.Username, field.Value))
+			}
+
+			return layouts.ServerLeaderboard(*props), renderer.Page{
+				Selector: "#root",
+				Title:    fmt.Sprintf("%s - %s", props.ServerInfo.Name, props.LeaderboardInfo.Name),
+				Lines:    lines,
+			}
+		})
+	})
+}
+
+// leaderboardPeriodStart gets the start of the weekly or monthly leaderboard period that was the given amount of periods ago.
+// Weeks start on Monday, matching how the leaderboards are archived.
+func leaderboardPeriodStart(timePeriod string, now time.Time, periodsAgo int) time.Time {
+	now = now.UTC()
+
+	if timePeriod == "monthly" {
+		return time.Date(now.Year(), now.Month()-time.Month(periodsAgo), 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	weekday := (int(today.Weekday()) + 6) % 7
+	return today.AddDate(0, 0, -weekday-(7*periodsAgo))
+}
+
+// resolveLeaderboardPeriod parses the period start for an archived leaderboard.
+// An empty period start means the current leaderboard, which is returned as 0.
+func resolveLeaderboardPeriod(timePeriod, periodStart string) (int32, error) {
+	if periodStart == "" {
+		return 0, nil
+	}
+
+	if timePeriod != "weekly" && timePeriod != "monthly" {
+		return 0, u.ErrInvalidLeaderboardTimeframe
+	}
+
+	if periodStart == "previous" {
+		return int32(leaderboardPeriodStart(timePeriod, time.Now(), 1).Unix()), nil
+	}
+
+	start, err := strconv.ParseInt(periodStart, 10, 32)
+	if err != nil || start <= 0 {
+		return 0, u.ErrInvalidLeaderboardPeriod
+	}
+
+	return int32(start), nil
+}
+
+func (uc *GuildUsecase) GetArchivedLeaderboardPeriods(ctx context.Context, guildId string, activityType, timePeriod string) ([]u.ArchivedLeaderboardPeriod, error) {
+	periods := make([]u.ArchivedLeaderboardPeriod, 0)
+
+	switch timePeriod {
+	case "weekly":
+		rows, err := uc.q.GetArchivedWeeklyActivityLeaderboardPeriods(ctx, db.GetArchivedWeeklyActivityLeaderboardPeriodsParams{
+			GuildID:   guildId,
+			GrantType: activityType,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			periods = append(periods, u.ArchivedLeaderboardPeriod{
+				TimePeriod:   timePeriod,
+				PeriodStart:  row.PeriodStart,
+				TotalMembers: row.TotalMembers,
+			})
+		}
+	case "monthly":
+		rows, err := uc.q.GetArchivedMonthlyActivityLeaderboardPeriods(ctx, db.GetArchivedMonthlyActivityLeaderboardPeriodsParams{
+			GuildID:   guildId,
+			GrantType: activityType,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			periods = append(periods, u.ArchivedLeaderboardPeriod{
+				TimePeriod:   timePeriod,
+				PeriodStart:  row.PeriodStart,
+				TotalMembers: row.TotalMembers,
+			})
+		}
+	default:
+		return nil, u.ErrInvalidLeaderboardTimeframe
 	}
 
+	return periods, nil
+}
+
+// leaderboardPage is a page of a leaderboard before the member usernames are resolved.
+type leaderboardPage struct {
+	Header     string
+	Page       int
+	TotalPages int32
+	UserIDs    []string
+	Fields     []layouts.LeaderboardDataField
+}
+
+func (uc *GuildUsecase) leaderboardPage(ctx context.Context, guildId string, activityType, timePeriod string, archivedPeriod int32, page int) (*leaderboardPage, error) {
 	limitBy := int32(15)
 
 	var header string
 	var totalPages int32
 	var userIds []string
 	var fields []layouts.LeaderboardDataField
-	var card gomponents.Node
 
 	switch timePeriod {
 	case "weekly":
+		if archivedPeriod > 0 {
+			header = fmt.Sprintf("Activity Points - Week of %s", time.Unix(int64(archivedPeriod), 0).UTC().Format("Jan 2, 2006"))
+
+			pages, err := uc.q.GetArchivedWeeklyActivityLeaderboardPages(ctx, db.GetArchivedWeeklyActivityLeaderboardPagesParams{
+				GuildID:   guildId,
+				GrantType: activityType,
+				WeekStart: archivedPeriod,
+				LimitBy:   limitBy,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			totalPages = pages
+			if int32(page) > totalPages {
+				page = 1
+			}
+
+			leaderboard, err := uc.q.GetArchivedWeeklyActivityLeaderboard(ctx, db.GetArchivedWeeklyActivityLeaderboardParams{
+				GuildID:   guildId,
+				GrantType: activityType,
+				WeekStart: archivedPeriod,
+				LimitBy:   limitBy,
+				OffsetBy:  int32(page-1) * limitBy,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			for _, value := range leaderboard {
+				userIds = append(userIds, value.MemberID)
+
+				fields = append(fields, layouts.LeaderboardDataField{
+					Rank:     int(value.Rank),
+					Username: value.MemberID,
+					Value:    int(value.EarnedPoints),
+				})
+			}
+
+			break
+		}
+
 		header = "Activity Points - Weekly"
 
 		pages, err := uc.q.GetWeeklyActivityLeaderboardPages(ctx, db.GetWeeklyActivityLeaderboardPagesParams{
@@ -456,6 +1206,48 @@ func (uc *GuildUsecase) GetGuildActivityLeaderboard(ctx context.Context, referer
 			})
 		}
 	case "monthly":
+		if archivedPeriod > 0 {
+			header = fmt.Sprintf("Activity Points - %s", time.Unix(int64(archivedPeriod), 0).UTC().Format("January 2006"))
+
+			pages, err := uc.q.GetArchivedMonthlyActivityLeaderboardPages(ctx, db.GetArchivedMonthlyActivityLeaderboardPagesParams{
+				GuildID:    guildId,
+				GrantType:  activityType,
+				MonthStart: archivedPeriod,
+				LimitBy:    limitBy,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			totalPages = pages
+			if int32(page) > totalPages {
+				page = 1
+			}
+
+			leaderboard, err := uc.q.GetArchivedMonthlyActivityLeaderboard(ctx, db.GetArchivedMonthlyActivityLeaderboardParams{
+				GuildID:    guildId,
+				GrantType:  activityType,
+				MonthStart: archivedPeriod,
+				LimitBy:    limitBy,
+				OffsetBy:   int32(page-1) * limitBy,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			for _, value := range leaderboard {
+				userIds = append(userIds, value.MemberID)
+
+				fields = append(fields, layouts.LeaderboardDataField{
+					Rank:     int(value.Rank),
+					Username: value.MemberID,
+					Value:    int(value.EarnedPoints),
+				})
+			}
+
+			break
+		}
+
 		header = "Activity Points - Monthly"
 
 		pages, err := uc.q.GetMonthlyActivityLeaderboardPages(ctx, db.GetMonthlyActivityLeaderboardPagesParams{
@@ -527,6 +1319,72 @@ func (uc *GuildUsecase) GetGuildActivityLeaderboard(ctx context.Context, referer
 		}
 	}
 
+	return &leaderboardPage{
+		Header:     header,
+		Page:       page,
+		TotalPages: totalPages,
+		UserIDs:    userIds,
+		Fields:     fields,
+	}, nil
+}
+
+// The referer is hashed since it's part of the rendered page, but can be any length.
+func leaderboardPageCacheKey(referer string, guildId string, activityType, timePeriod string, archivedPeriod int32, page int) func(version int64) string {
+	refererHash := sha256.Sum256([]byte(referer))
+	subject := fmt.Sprintf("%s:%s:%d:%d:%s", activityType, timePeriod, leaderboardPeriodKey(timePeriod, archivedPeriod), page, hex.EncodeToString(refererHash[:8]))
+
+	return func(version int64) string {
+		return renderedCardCacheKey("leaderboard-page", guildId, subject, "json", version)
+	}
+}
+
+func (uc *GuildUsecase) GetGuildActivityLeaderboardETag(ctx context.Context, referer string, guildId string, activityType, timePeriod, periodStart string, page int) (string, error) {
+	archivedPeriod, err := resolveLeaderboardPeriod(timePeriod, periodStart)
+	if err != nil {
+		return "", err
+	}
+
+	return versionedCardETag(ctx, uc.c, guildId, leaderboardPageCacheKey(referer, guildId, activityType, timePeriod, archivedPeriod, page)), nil
+}
+
+func (uc *GuildUsecase) GetGuildActivityLeaderboard(ctx context.Context, referer string, guildId string, activityType, timePeriod, periodStart string, page int) (*u.GuildLeaderboard, error) {
+	archivedPeriod, err := resolveLeaderboardPeriod(timePeriod, periodStart)
+	if err != nil {
+		return nil, err
+	}
+
+	return getVersionedCard(ctx, uc.c, guildId, leaderboardPageCacheKey(referer, guildId, activityType, timePeriod, archivedPeriod, page), func(ctx context.Context) (*u.GuildLeaderboard, error) {
+		return uc.guildActivityLeaderboard(ctx, referer, guildId, activityType, timePeriod, archivedPeriod, page)
+	})
+}
+
+func (uc *GuildUsecase) guildActivityLeaderboard(ctx context.Context, referer string, guildId string, activityType, timePeriod string, archivedPeriod int32, page int) (*u.GuildLeaderboard, error) {
+	guild, err := uc.d.Guild(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverInfo := layouts.ServerInfo{
+		Icon: guild.IconURL("100"),
+		Name: guild.Name,
+	}
+
+	cached, err := db_cache.GetWithTTL(ctx, uc.c, leaderboardCacheKey(guildId, activityType, timePeriod, archivedPeriod, page), leaderboardCacheTTL, func(ctx context.Context) (*leaderboardPage, error) {
+		return uc.leaderboardPage(ctx, guildId, activityType, timePeriod, archivedPeriod, page)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	header := cached.Header
+	totalPages := cached.TotalPages
+	userIds := cached.UserIDs
+	page = cached.Page
+
+	// The cached page can be shared between requests, so the usernames are resolved on a copy.
+	fields := slices.Clone(cached.Fields)
+	var card gomponents.Node
+
 	if len(fields) <= 0 {
 		return nil, u.ErrLeaderboardNoRows
 	}
@@ -555,9 +1413,14 @@ func (uc *GuildUsecase) GetGuildActivityLeaderboard(ctx context.Context, referer
 	renderedCard := bufferpool.Buffers.Get()
 	defer bufferpool.Buffers.Put(renderedCard)
 
+	_, renderSpan := tracing.Start(ctx, "render leaderboard card")
+	renderStart := time.Now()
 	if err := card.Render(renderedCard); err != nil {
+		tracing.End(renderSpan, err)
 		return nil, err
 	}
+	metrics.ObserveRender("leaderboard", renderStart)
+	renderSpan.End()
 
 	return &u.GuildLeaderboard{
 		HTML: renderedCard.String(),
@@ -565,6 +1428,7 @@ func (uc *GuildUsecase) GetGuildActivityLeaderboard(ctx context.Context, referer
 		CurrentPage: int32(page),
 		TotalPages:  totalPages,
 		HasNextPage: int32(page) < totalPages,
+		PeriodStart: archivedPeriod,
 	}, nil
 }
 
@@ -608,7 +1472,9 @@ func (uc *GuildUsecase) CreateVoiceRoomLobby(ctx context.Context, guildId string
 		return nil, err
 	}
 
-	return &u.VoiceRoomLobby{
+	uc.invalidateGuildSettings(ctx, guildId)
+
+	created := &u.VoiceRoomLobby{
 		ChannelID:      lobby.VoiceChannelID,
 		UserLimit:      lobby.UserLimit,
 		CanRename:      lobby.CanRename,
@@ -616,7 +1482,10 @@ func (uc *GuildUsecase) CreateVoiceRoomLobby(ctx context.Context, guildId string
 		CanAdjustLimit: lobby.CanAdjustLimit,
 
 		OpenedRooms: rooms,
-	}, nil
+	}
+	uc.recordSettingsChange(ctx, guildId, "voice_room_lobby.create", originChannelId, nil, toVoiceRoomLobbyAudit(created))
+
+	return created, nil
 }
 
 func (uc *GuildUsecase) GetVoiceRoomLobby(ctx context.Context, guildId string, originChannelId string) (*u.VoiceRoomLobby, error) {
@@ -653,6 +1522,11 @@ func (uc *GuildUsecase) GetVoiceRoomLobby(ctx context.Context, guildId string, o
 }
 
 func (uc *GuildUsecase) UpdateVoiceRoomLobby(ctx context.Context, guildId string, originChannelId string, settings u.VoiceRoomLobbySettings) (*u.VoiceRoomLobby, error) {
+	previous, err := uc.GetVoiceRoomLobby(ctx, guildId, originChannelId)
+	if err != nil {
+		return nil, err
+	}
+
 	lobby, err := uc.q.UpdateVoiceRoomLobby(ctx, db.UpdateVoiceRoomLobbyParams{
 		GuildID:        guildId,
 		VoiceChannelID: originChannelId,
@@ -679,7 +1553,9 @@ func (uc *GuildUsecase) UpdateVoiceRoomLobby(ctx context.Context, guildId string
 		return nil, err
 	}
 
-	return &u.VoiceRoomLobby{
+	uc.invalidateGuildSettings(ctx, guildId)
+
+	updated := &u.VoiceRoomLobby{
 		ChannelID:      lobby.VoiceChannelID,
 		UserLimit:      lobby.UserLimit,
 		CanRename:      lobby.CanRename,
@@ -687,19 +1563,15 @@ func (uc *GuildUsecase) UpdateVoiceRoomLobby(ctx context.Context, guildId string
 		CanAdjustLimit: lobby.CanAdjustLimit,
 
 		OpenedRooms: rooms,
-	}, nil
+	}
+	uc.recordSettingsChange(ctx, guildId, "voice_room_lobby.update", originChannelId, toVoiceRoomLobbyAudit(previous), toVoiceRoomLobbyAudit(updated))
+
+	return updated, nil
 }
 
 func (uc *GuildUsecase) DeleteVoiceRoomLobby(ctx context.Context, guildId string, originChannelId string) error {
-	_, err := uc.q.GetVoiceRoomLobby(ctx, db.GetVoiceRoomLobbyParams{
-		GuildID:        guildId,
-		VoiceChannelID: originChannelId,
-	})
+	lobby, err := uc.GetVoiceRoomLobby(ctx, guildId, originChannelId)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return u.ErrVoiceRoomLobbyNotFound
-		}
-
 		return err
 	}
 
@@ -712,6 +1584,9 @@ func (uc *GuildUsecase) DeleteVoiceRoomLobby(ctx context.Context, guildId string
 		return err
 	}
 
+	uc.invalidateGuildSettings(ctx, guildId)
+	uc.recordSettingsChange(ctx, guildId, "voice_room_lobby.delete", originChannelId, toVoiceRoomLobbyAudit(lobby), nil)
+
 	return nil
 }
 
@@ -740,6 +1615,8 @@ func (uc *GuildUsecase) RegisterVoiceRoom(ctx context.Context, guildId string, o
 		return nil, err
 	}
 
+	uc.invalidateGuildSettings(ctx, guildId)
+
 	return &u.VoiceRoom{
 		OriginChannelId: room.OriginChannelID,
 		CreatorId:       room.CreatedByUserID,
@@ -841,5 +1718,164 @@ func (uc *GuildUsecase) DeleteVoiceRoom(ctx context.Context, guildId string, cha
 		return err
 	}
 
+	uc.invalidateGuildSettings(ctx, guildId)
+
+	return nil
+}
+
+func toActivityBoost(boost db.GuildActivityBoost) u.ActivityBoost {
+	now := time.Now().Unix()
+
+	return u.ActivityBoost{
+		BoostID:      boost.BoostID,
+		Name:         boost.Name,
+		ActivityType: boost.GrantType,
+		Multiplier:   boost.Multiplier,
+		RoleIDs:      boost.RoleIds,
+		StartsAt:     int64(boost.StartsAt),
+		EndsAt:       int64(boost.EndsAt),
+		IsActive:     int64(boost.StartsAt) <= now && now < int64(boost.EndsAt),
+	}
+}
+
+func (uc *GuildUsecase) GetActivityBoosts(ctx context.Context, guildId string) ([]u.ActivityBoost, error) {
+	return uc.activityBoosts(ctx, uc.q, guildId)
+}
+
+func (uc *GuildUsecase) activityBoosts(ctx context.Context, q db.Querier, guildId string) ([]u.ActivityBoost, error) {
+	rows, err := q.GetActivityBoosts(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	boosts := make([]u.ActivityBoost, 0)
+	for _, row := range rows {
+		boosts = append(boosts, toActivityBoost(row))
+	}
+
+	return boosts, nil
+}
+
+func (uc *GuildUsecase) GetActivityBoost(ctx context.Context, guildId string, boostId int32) (*u.ActivityBoost, error) {
+	row, err := uc.q.GetActivityBoost(ctx, db.GetActivityBoostParams{
+		GuildID: guildId,
+		BoostID: boostId,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, u.ErrActivityBoostNotFound
+		}
+
+		return nil, err
+	}
+
+	boost := toActivityBoost(row)
+	return &boost, nil
+}
+
+func (uc *GuildUsecase) CreateActivityBoost(ctx context.Context, guildId string, opts u.CreateActivityBoostOpts) (*u.ActivityBoost, error) {
+	if opts.ActivityType != "chat" && opts.ActivityType != "voice" {
+		return nil, u.ErrInvalidActivityType
+	}
+
+	roleIds := opts.RoleIDs
+	if roleIds == nil {
+		roleIds = []string{}
+	}
+
+	row, err := uc.q.CreateActivityBoost(ctx, db.CreateActivityBoostParams{
+		GuildID:    guildId,
+		Name:       opts.Name,
+		GrantType:  opts.ActivityType,
+		Multiplier: opts.Multiplier,
+		RoleIds:    roleIds,
+		StartsAt:   opts.StartsAt,
+		EndsAt:     opts.EndsAt,
+	})
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) {
+			switch pqErr.Code {
+			case "23503":
+				return nil, u.ErrGuildNotFound
+			case "23514":
+				return nil, u.ErrInvalidActivityBoost
+			}
+		}
+
+		return nil, err
+	}
+
+	uc.invalidateGuildSettings(ctx, guildId)
+
+	boost := toActivityBoost(row)
+	uc.recordSettingsChange(ctx, guildId, "activity_boost.create", strconv.Itoa(int(boost.BoostID)), nil, boost)
+
+	return &boost, nil
+}
+
+func (uc *GuildUsecase) UpdateActivityBoost(ctx context.Context, guildId string, boostId int32, opts u.UpdateActivityBoostOpts) (*u.ActivityBoost, error) {
+	previous, err := uc.GetActivityBoost(ctx, guildId, boostId)
+	if err != nil {
+		return nil, err
+	}
+
+	var multiplier sql.NullFloat64
+	if opts.Multiplier != nil {
+		multiplier = sql.NullFloat64{Float64: float64(*opts.Multiplier), Valid: true}
+	}
+
+	row, err := uc.q.UpdateActivityBoost(ctx, db.UpdateActivityBoostParams{
+		GuildID: guildId,
+		BoostID: boostId,
+
+		Name:       sqlx.String(opts.Name),
+		Multiplier: multiplier,
+		RoleIds:    opts.RoleIDs,
+		StartsAt:   sqlx.Int32(opts.StartsAt),
+		EndsAt:     sqlx.Int32(opts.EndsAt),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, u.ErrActivityBoostNotFound
+		}
+
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23514" {
+			return nil, u.ErrInvalidActivityBoost
+		}
+
+		return nil, err
+	}
+
+	uc.invalidateGuildSettings(ctx, guildId)
+
+	boost := toActivityBoost(row)
+	uc.recordSettingsChange(ctx, guildId, "activity_boost.update", strconv.Itoa(int(boostId)), previous, boost)
+
+	return &boost, nil
+}
+
+func (uc *GuildUsecase) DeleteActivityBoost(ctx context.Context, guildId string, boostId int32) error {
+	previous, err := uc.GetActivityBoost(ctx, guildId, boostId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := uc.q.DeleteActivityBoost(ctx, db.DeleteActivityBoostParams{
+		GuildID: guildId,
+		BoostID: boostId,
+	})
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return u.ErrActivityBoostNotFound
+	}
+
+	uc.invalidateGuildSettings(ctx, guildId)
+	uc.recordSettingsChange(ctx, guildId, "activity_boost.delete", strconv.Itoa(int(boostId)), previous, nil)
+
 	return nil
 }
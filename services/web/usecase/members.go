@@ -5,217 +5,1091 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"math"
+	"slices"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
 	"github.com/typical-developers/discord-bot-backend/internal/db"
 	"github.com/typical-developers/discord-bot-backend/internal/pages/layouts"
 	u "github.com/typical-developers/discord-bot-backend/internal/usecase"
+	db_cache "github.com/typical-developers/discord-bot-backend/pkg/db-cache"
 	discord_state "github.com/typical-developers/discord-bot-backend/pkg/discord-state"
+	"github.com/typical-developers/discord-bot-backend/pkg/renderer"
 	"maragu.dev/gomponents"
 )
 
 type MemberUsecase struct {
 	db *sql.DB
-	q  *db.Queries
+	q  db.TxQuerier
 	d  *discord_state.StateManager
+	c  *db_cache.Cache
+
+	// When set, profile cards can be rendered to images.
+	r renderer.Renderer
+
+	// When set, chat activity grants are buffered and written to the database in batches.
+	grants *ChatGrantBuffer
+}
+
+// NewMemberUsecase creates the member usecase, the grant buffer is optional.
+// Buffering chat activity grants requires the cache, since it's used to track cooldowns.
+func NewMemberUsecase(db *sql.DB, q db.TxQuerier, d *discord_state.StateManager, c *db_cache.Cache, r renderer.Renderer, grants *ChatGrantBuffer) u.MemberUsecase {
+	uc := &MemberUsecase{db: db, q: q, d: d, c: c, r: r, grants: grants}
+	if grants != nil {
+		grants.onFlushed = uc.chatGrantsFlushed
+	}
+
+	return uc
+}
+
+func (uc *MemberUsecase) CreateMemberProfile(ctx context.Context, guildId string, userId string) (*u.MemberProfile, error) {
+	_, err := uc.d.GuildMember(ctx, guildId, userId)
+	if err != nil {
+		var dgErr *discordgo.RESTError
+		if errors.As(err, &dgErr) && dgErr.Message.Code == discordgo.ErrCodeUnknownMember {
+			return nil, u.ErrMemberNotInGuild
+		}
+
+		return nil, err
+	}
+
+	_, err = uc.q.CreateMemberProfile(ctx, db.CreateMemberProfileParams{
+		GuildID:  guildId,
+		MemberID: userId,
+	})
+
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return nil, u.ErrMemberProfileExists
+		}
+
+		return nil, err
+	}
+
+	return uc.GetMemberProfile(ctx, guildId, userId)
+}
+
+// memberActivity builds the activity information for a member based on the grant type.
+func (uc *MemberUsecase) memberActivity(ctx context.Context, guildId string, grantType string, rank int64, points int32, lastGrantEpoch int32, cooldown int32) (*u.MemberActivity, error) {
+	activityInfo, err := uc.q.GetMemberActivityRoleInfo(ctx, db.GetMemberActivityRoleInfoParams{
+		GuildID:   guildId,
+		GrantType: grantType,
+		Points:    points,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	lastGrant := time.Unix(int64(lastGrantEpoch), 0)
+	nextGrant := lastGrant.Add(time.Duration(cooldown) * time.Second)
+
+	activity := &u.MemberActivity{
+		Rank:           int32(rank),
+		Points:         points,
+		LastGrantEpoch: int64(lastGrantEpoch),
+		IsOnCooldown:   time.Now().Before(nextGrant),
+
+		CurrentActivityRoleIds: make([]string, 0),
+	}
+
+	if activityInfo.CurrentRoleID.Valid {
+		role, err := uc.d.GuildRole(ctx, guildId, activityInfo.CurrentRoleID.String)
+		if err == nil {
+			activity.CurrentActivityRole = &u.MemberActivityRole{
+				RoleID:         role.ID,
+				Name:           role.Name,
+				Accent:         fmt.Sprintf("#%06X", role.Color),
+				RequiredPoints: int32(activityInfo.CurrentRoleRequiredPoints.Int32),
+			}
+		}
+	}
+
+	if activityInfo.NextRoleID.Valid {
+		activity.NextActivityRole = &u.MemberActivityProgress{
+			CurrentProgress:  points - activityInfo.CurrentRoleRequiredPoints.Int32,
+			RequiredProgress: activityInfo.NextRoleRequiredPoints.Int32 - activityInfo.CurrentRoleRequiredPoints.Int32,
+		}
+	}
+
+	if len(activityInfo.CurrentRolesIds) > 0 {
+		activity.CurrentActivityRoleIds = activityInfo.CurrentRolesIds
+	}
+
+	return activity, nil
+}
+
+func (uc *MemberUsecase) GetMemberProfile(ctx context.Context, guildId string, userId string) (*u.MemberProfile, error) {
+	guildMember, err := uc.d.GuildMember(ctx, guildId, userId)
+	if err != nil {
+		var dgErr *discordgo.RESTError
+		if errors.As(err, &dgErr) && dgErr.Message.Code == discordgo.ErrCodeUnknownMember {
+			return nil, u.ErrMemberNotInGuild
+		}
+
+		return nil, err
+	}
+
+	chatActivitySettings, err := uc.q.GetGuildChatActivitySettings(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	voiceActivitySettings, err := uc.q.GetGuildVoiceActivitySettings(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := uc.q.GetMemberProfile(ctx, db.GetMemberProfileParams{
+		GuildID:  guildId,
+		MemberID: userId,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, u.ErrMemberProfileNotFound
+		}
+
+		return nil, err
+	}
+
+	chatActivity, err := uc.memberActivity(ctx, guildId, "chat",
+		profile.ChatActivityRank, profile.ChatActivity, profile.LastChatActivityGrant, chatActivitySettings.GrantCooldown,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	voiceActivity, err := uc.memberActivity(ctx, guildId, "voice",
+		profile.VoiceActivityRank, profile.VoiceActivity, profile.LastVoiceActivityGrant, voiceActivitySettings.GrantCooldown,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &u.MemberProfile{
+		DisplayName: guildMember.DisplayName(),
+		Username:    guildMember.User.Username,
+		AvatarURL:   guildMember.AvatarURL("100"),
+
+		CardStyle:     int32(profile.CardStyle),
+		ChatActivity:  *chatActivity,
+		VoiceActivity: *voiceActivity,
+	}, nil
 }
 
-func NewMemberUsecase(db *sql.DB, q *db.Queries, d *discord_state.StateManager) u.MemberUsecase {
-	return &MemberUsecase{db: db, q: q, d: d}
-}
+// activityHistoryPeriods fills in the periods the member did not earn any points in, so the history has no gaps.
+func activityHistoryPeriods(timePeriod string, now time.Time, periods int, archived map[int32]u.MemberActivityPeriod, current u.MemberActivityPeriod) []u.MemberActivityPeriod {
+	history := make([]u.MemberActivityPeriod, 0, periods+1)
+
+	for ago := periods; ago > 0; ago-- {
+		start := int32(leaderboardPeriodStart(timePeriod, now, ago).Unix())
+
+		period, ok := archived[start]
+		if !ok {
+			period = u.MemberActivityPeriod{PeriodStart: start}
+		}
+
+		history = append(history, period)
+	}
+
+	return append(history, current)
+}
+
+// activityStreakAndTrend gets the amount of consecutive weeks points were earned in, and whether the last full week earned more or less than the one before it.
+func activityStreakAndTrend(weekly []u.MemberActivityPeriod) (int32, string) {
+	// The current week isn't over yet, so it shouldn't break the streak when nothing has been earned in it so far.
+	streak := int32(0)
+	for index := len(weekly) - 1; index >= 0; index-- {
+		if weekly[index].EarnedPoints > 0 {
+			streak++
+			continue
+		}
+
+		if weekly[index].IsCurrent {
+			continue
+		}
+
+		break
+	}
+
+	trend := "flat"
+	if len(weekly) >= 3 {
+		last, previous := weekly[len(weekly)-2].EarnedPoints, weekly[len(weekly)-3].EarnedPoints
+
+		switch {
+		case last > previous:
+			trend = "up"
+		case last < previous:
+			trend = "down"
+		}
+	}
+
+	return streak, trend
+}
+
+func (uc *MemberUsecase) GetMemberActivityHistory(ctx context.Context, guildId string, userId string, activityType string, weeks, months int) (*u.MemberActivityHistory, error) {
+	if activityType != "chat" && activityType != "voice" {
+		return nil, u.ErrInvalidActivityType
+	}
+
+	_, err := uc.q.GetMemberProfile(ctx, db.GetMemberProfileParams{
+		GuildID:  guildId,
+		MemberID: userId,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, u.ErrMemberProfileNotFound
+		}
+
+		return nil, err
+	}
+
+	now := time.Now()
+
+	weeklyRows, err := uc.q.GetMemberWeeklyActivityHistory(ctx, db.GetMemberWeeklyActivityHistoryParams{
+		GuildID:   guildId,
+		MemberID:  userId,
+		GrantType: activityType,
+		Since:     int32(leaderboardPeriodStart("weekly", now, weeks).Unix()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	monthlyRows, err := uc.q.GetMemberMonthlyActivityHistory(ctx, db.GetMemberMonthlyActivityHistoryParams{
+		GuildID:   guildId,
+		MemberID:  userId,
+		GrantType: activityType,
+		Since:     int32(leaderboardPeriodStart("monthly", now, months).Unix()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := uc.q.GetMemberCurrentActivityPeriods(ctx, db.GetMemberCurrentActivityPeriodsParams{
+		GuildID:   guildId,
+		MemberID:  userId,
+		GrantType: activityType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	weeklyArchive := make(map[int32]u.MemberActivityPeriod)
+	for _, row := range weeklyRows {
+		weeklyArchive[row.WeekStart] = u.MemberActivityPeriod{
+			PeriodStart:  row.WeekStart,
+			EarnedPoints: row.EarnedPoints,
+			Rank:         row.Rank,
+		}
+	}
+
+	monthlyArchive := make(map[int32]u.MemberActivityPeriod)
+	for _, row := range monthlyRows {
+		monthlyArchive[row.MonthStart] = u.MemberActivityPeriod{
+			PeriodStart:  row.MonthStart,
+			EarnedPoints: row.EarnedPoints,
+			Rank:         row.Rank,
+		}
+	}
+
+	weekly := activityHistoryPeriods("weekly", now, weeks, weeklyArchive, u.MemberActivityPeriod{
+		PeriodStart:  int32(leaderboardPeriodStart("weekly", now, 0).Unix()),
+		EarnedPoints: current.WeeklyEarnedPoints,
+		Rank:         current.WeeklyRank,
+		IsCurrent:    true,
+	})
+
+	monthly := activityHistoryPeriods("monthly", now, months, monthlyArchive, u.MemberActivityPeriod{
+		PeriodStart:  int32(leaderboardPeriodStart("monthly", now, 0).Unix()),
+		EarnedPoints: current.MonthlyEarnedPoints,
+		Rank:         current.MonthlyRank,
+		IsCurrent:    true,
+	})
+
+	streak, trend := activityStreakAndTrend(weekly)
+
+	return &u.MemberActivityHistory{
+		ActivityType: activityType,
+		Weekly:       weekly,
+		Monthly:      monthly,
+		WeeklyStreak: streak,
+		WeeklyTrend:  trend,
+	}, nil
+}
+
+// activityBoostMultiplier resolves the multiplier from the guild's currently active boosts.
+// When multiple boosts apply to the member, the highest multiplier is used.
+func (uc *MemberUsecase) activityBoostMultiplier(ctx context.Context, guildId string, userId string, grantType string) (float32, error) {
+	boosts, err := uc.q.GetActiveActivityBoosts(ctx, db.GetActiveActivityBoostsParams{
+		GuildID:   guildId,
+		GrantType: grantType,
+		At:        int32(time.Now().Unix()),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var member *discordgo.Member
+	multiplier := float32(1)
+	for _, boost := range boosts {
+		if boost.Multiplier <= multiplier {
+			continue
+		}
+
+		if len(boost.RoleIds) > 0 {
+			// Role filtered boosts are skipped if the member can't be fetched, for example: they left the guild.
+			if member == nil {
+				member, err = uc.d.GuildMember(ctx, guildId, userId)
+				if member == nil || err != nil {
+					member = &discordgo.Member{}
+				}
+			}
+
+			if !slices.ContainsFunc(member.Roles, func(roleId string) bool {
+				return slices.Contains(boost.RoleIds, roleId)
+			}) {
+				continue
+			}
+		}
+
+		multiplier = boost.Multiplier
+	}
+
+	return multiplier, nil
+}
+
+// shouldCreateProfile resolves whether a grant creates the member's profile, the override takes priority over the guild's setting.
+func shouldCreateProfile(autoCreateProfiles bool, override *bool) bool {
+	if override != nil {
+		return *override
+	}
+
+	return autoCreateProfiles
+}
+
+// cooldownRemaining gets how many seconds are left on the cooldown since the last grant, which is 0 once it has passed.
+func cooldownRemaining(lastGrant int32, cooldown int32, now time.Time) int32 {
+	return int32(max(0, int64(lastGrant)+int64(cooldown)-now.Unix()))
+}
+
+// incrementActivityPoints grants the points to the member in a single transaction, returning whether the member's profile was created.
+//
+// The cooldown is checked by the update itself, so concurrent grants for the same member can't both be applied.
+// When the cooldown hasn't passed, this returns a u.CooldownError.
+//
+// When createProfile is set, the profile is created in the same transaction, so it's never left without the grant.
+// The member has to already be known to be in the guild.
+func (uc *MemberUsecase) incrementActivityPoints(ctx context.Context, guildId string, userId string, grantType string, channelId string, points int32, cooldown int32, createProfile bool) (bool, error) {
+	tx, err := uc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	q := uc.q.InTx(tx)
+
+	created := false
+	if createProfile {
+		rows, err := q.EnsureMemberProfile(ctx, db.EnsureMemberProfileParams{
+			GuildID:  guildId,
+			MemberID: userId,
+		})
+		if err != nil {
+			_ = tx.Rollback()
+			return false, err
+		}
+
+		created = rows > 0
+	}
+
+	err = db.GrantActivityPoints(ctx, q, guildId, userId, grantType, channelId, points, cooldown)
+	if errors.Is(err, sql.ErrNoRows) {
+		// Nothing was updated, either because the profile doesn't exist or another grant got there first.
+		profile, profileErr := q.GetMemberProfile(ctx, db.GetMemberProfileParams{
+			GuildID:  guildId,
+			MemberID: userId,
+		})
+		_ = tx.Rollback()
+
+		if profileErr != nil {
+			if errors.Is(profileErr, sql.ErrNoRows) {
+				return false, u.ErrMemberProfileNotFound
+			}
+
+			return false, profileErr
+		}
+
+		lastGrant := profile.LastChatActivityGrant
+		if grantType == "voice" {
+			lastGrant = profile.LastVoiceActivityGrant
+		}
+
+		return false, u.NewCooldownError(max(1, cooldownRemaining(lastGrant, cooldown, time.Now())))
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	bumpCardDataVersion(ctx, uc.c, guildId)
+	return created, nil
+}
+
+// chatActivityMultiplier resolves the multiplier for the channel the message was sent in.
+// The channel's own multiplier takes priority, followed by its parent channel (for threads) and then its category.
+func (uc *MemberUsecase) chatActivityMultiplier(ctx context.Context, guildId string, channelId string) (float32, error) {
+	if channelId == "" {
+		return 1, nil
+	}
+
+	channelIds := []string{channelId}
+	if channel, err := uc.d.Channel(ctx, channelId); err == nil && channel.ParentID != "" {
+		channelIds = append(channelIds, channel.ParentID)
+
+		if channel.IsThread() {
+			if parent, err := uc.d.Channel(ctx, channel.ParentID); err == nil && parent.ParentID != "" {
+				channelIds = append(channelIds, parent.ParentID)
+			}
+		}
+	}
+
+	multiplier, err := uc.q.ResolveChatActivityChannelMultiplier(ctx, db.ResolveChatActivityChannelMultiplierParams{
+		GuildID:    guildId,
+		ChannelIds: channelIds,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 1, nil
+		}
+
+		return 0, err
+	}
+
+	return multiplier, nil
+}
+
+// bufferChatActivityPoints does the same checks as a normal chat grant, but against the cache instead of the database.
+// The grant is then queued to be written with the next flush, returning whether the member's profile was created for it.
+//
+// Created profiles can't be part of the flush's transaction, so they're created right before the grant is queued.
+func (uc *MemberUsecase) bufferChatActivityPoints(ctx context.Context, guildId string, userId string, channelId string, createProfile *bool) (bool, error) {
+	chatActivitySettings, err := db_cache.Get(ctx, uc.c, chatActivitySettingsCacheKey(guildId), func(ctx context.Context) (db.GetGuildChatActivitySettingsRow, error) {
+		return uc.q.GetGuildChatActivitySettings(ctx, guildId)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if !chatActivitySettings.IsEnabled {
+		return false, u.ErrChatActivityTrackingDisabled
+	}
+
+	// This also makes sure the member is still in the guild, so profiles aren't created for members that left.
+	if err := uc.checkGrantDenyRoles(ctx, guildId, userId, chatActivitySettings.DenyRoles); err != nil {
+		return false, err
+	}
+
+	// Profiles aren't removed, so only the profile existing is cached.
+	_, err = db_cache.Get(ctx, uc.c, memberProfileExistsCacheKey(guildId, userId), func(ctx context.Context) (bool, error) {
+		_, err := uc.q.GetMemberProfile(ctx, db.GetMemberProfileParams{
+			GuildID:  guildId,
+			MemberID: userId,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return false, u.ErrMemberProfileNotFound
+			}
+
+			return false, err
+		}
+
+		return true, nil
+	})
+	missing := errors.Is(err, u.ErrMemberProfileNotFound)
+	if err != nil && (!missing || !shouldCreateProfile(chatActivitySettings.AutoCreateProfiles, createProfile)) {
+		return false, err
+	}
+
+	multiplier, err := uc.chatActivityMultiplier(ctx, guildId, channelId)
+	if err != nil {
+		return false, err
+	}
+
+	if multiplier <= 0 {
+		return false, u.ErrChannelActivityExcluded
+	}
+
+	boost, err := uc.activityBoostMultiplier(ctx, guildId, userId, "chat")
+	if err != nil {
+		return false, err
+	}
+
+	// The profile is created before the cooldown is claimed, otherwise failing to create it would leave the member on cooldown without being granted anything.
+	created := false
+	if missing {
+		rows, err := uc.q.EnsureMemberProfile(ctx, db.EnsureMemberProfileParams{
+			GuildID:  guildId,
+			MemberID: userId,
+		})
+		if err != nil {
+			return false, err
+		}
+
+		created = rows > 0
+	}
+
+	if chatActivitySettings.GrantCooldown > 0 {
+		ok, err := uc.c.SetIfAbsent(ctx, chatGrantCooldownKey(guildId, userId), time.Duration(chatActivitySettings.GrantCooldown)*time.Second)
+		if err != nil {
+			return false, err
+		}
+
+		if !ok {
+			ttl, err := uc.c.TTL(ctx, chatGrantCooldownKey(guildId, userId))
+			if err != nil {
+				return false, err
+			}
+
+			return false, u.NewCooldownError(max(1, int32(math.Ceil(ttl.Seconds()))))
+		}
+	}
+
+	points := int32(math.Round(float64(chatActivitySettings.GrantAmount) * float64(multiplier) * float64(boost)))
+	uc.grants.Add(guildId, userId, channelId, points, time.Now())
+
+	return created, nil
+}
+
+// chatGrantsFlushed runs once buffered chat activity grants have been written.
+func (uc *MemberUsecase) chatGrantsFlushed(ctx context.Context, members []chatGrantKey) {
+	guildIds := make([]string, 0)
+	for _, member := range members {
+		if !slices.Contains(guildIds, member.guildId) {
+			guildIds = append(guildIds, member.guildId)
+		}
+	}
+	bumpCardDataVersion(ctx, uc.c, guildIds...)
+
+	uc.assignBufferedActivityRoles(ctx, members)
+}
+
+// assignBufferedActivityRoles assigns the activity roles for members once their buffered grants have been written.
+func (uc *MemberUsecase) assignBufferedActivityRoles(ctx context.Context, members []chatGrantKey) {
+	for _, member := range members {
+		logger := log.WithContext(ctx).WithFields(log.Fields{
+			"guild_id":  member.guildId,
+			"member_id": member.userId,
+		})
+
+		chatActivitySettings, err := db_cache.Get(ctx, uc.c, chatActivitySettingsCacheKey(member.guildId), func(ctx context.Context) (db.GetGuildChatActivitySettingsRow, error) {
+			return uc.q.GetGuildChatActivitySettings(ctx, member.guildId)
+		})
+		if err != nil {
+			logger.WithError(err).Error("Failed to get the chat activity settings for buffered grants.")
+			continue
+		}
+
+		if !chatActivitySettings.AutoAssignRoles {
+			continue
+		}
+
+		profile, err := uc.GetMemberProfile(ctx, member.guildId, member.userId)
+		if err != nil {
+			logger.WithError(err).Error("Failed to get the member profile for buffered grants.")
+			continue
+		}
+
+		if _, err := uc.assignActivityRoles(ctx, member.guildId, member.userId, profile.ChatActivity, chatActivitySettings.RoleAssignmentMode); err != nil {
+			logger.WithError(err).Error("Failed to assign activity roles for buffered grants.")
+		}
+	}
+}
+
+func (uc *MemberUsecase) IncrementMemberChatActivityPoints(ctx context.Context, guildId string, userId string, channelId string, createProfile *bool) (*u.ActivityGrant, error) {
+	if uc.grants != nil {
+		created, err := uc.bufferChatActivityPoints(ctx, guildId, userId, channelId, createProfile)
+		if err != nil {
+			return nil, err
+		}
+
+		return &u.ActivityGrant{ProfileCreated: created}, nil
+	}
+
+	chatActivitySettings, err := uc.q.GetGuildChatActivitySettings(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !chatActivitySettings.IsEnabled {
+		return nil, u.ErrChatActivityTrackingDisabled
+	}
+
+	// This also makes sure the member is still in the guild, so profiles aren't created for members that left.
+	if err := uc.checkGrantDenyRoles(ctx, guildId, userId, chatActivitySettings.DenyRoles); err != nil {
+		return nil, err
+	}
+
+	createMissingProfile := shouldCreateProfile(chatActivitySettings.AutoCreateProfiles, createProfile)
+	profile, err := uc.q.GetMemberProfile(ctx, db.GetMemberProfileParams{
+		GuildID:  guildId,
+		MemberID: userId,
+	})
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return nil, err
+		}
+
+		if !createMissingProfile {
+			return nil, u.ErrMemberProfileNotFound
+		}
+	} else if remaining := cooldownRemaining(profile.LastChatActivityGrant, chatActivitySettings.GrantCooldown, time.Now()); remaining > 0 {
+		// This only skips the work below for members that are clearly on cooldown, the grant itself enforces it.
+		return nil, u.NewCooldownError(remaining)
+	}
+
+	multiplier, err := uc.chatActivityMultiplier(ctx, guildId, channelId)
+	if err != nil {
+		return nil, err
+	}
+
+	if multiplier <= 0 {
+		return nil, u.ErrChannelActivityExcluded
+	}
+
+	boost, err := uc.activityBoostMultiplier(ctx, guildId, userId, "chat")
+	if err != nil {
+		return nil, err
+	}
+
+	points := int32(math.Round(float64(chatActivitySettings.GrantAmount) * float64(multiplier) * float64(boost)))
 
-func (uc *MemberUsecase) CreateMemberProfile(ctx context.Context, guildId string, userId string) (*u.MemberProfile, error) {
-	_, err := uc.d.GuildMember(ctx, guildId, userId)
+	created, err := uc.incrementActivityPoints(ctx, guildId, userId, "chat", channelId, points, chatActivitySettings.GrantCooldown, createMissingProfile)
 	if err != nil {
-		var dgErr *discordgo.RESTError
-		if errors.As(err, &dgErr) && dgErr.Message.Code == discordgo.ErrCodeUnknownMember {
-			return nil, u.ErrMemberNotInGuild
-		}
+		return nil, err
+	}
 
+	// TODO:
+	// This increases the return time for this request. We do similar fetches (outside of roles) in the method used below.
+	//
+	// It would be better to have some sort of structure that can have its data set and return the new structure
+	// instead of fetching everything again.
+	updated, err := uc.GetMemberProfile(ctx, guildId, userId)
+	if err != nil {
 		return nil, err
 	}
 
-	_, err = uc.q.CreateMemberProfile(ctx, db.CreateMemberProfileParams{
-		GuildID:  guildId,
-		MemberID: userId,
-	})
+	// The points have already been granted, so the grant still succeeds when the roles can't be assigned.
+	if chatActivitySettings.AutoAssignRoles {
+		updated.RoleChanges, err = uc.assignActivityRoles(ctx, guildId, userId, updated.ChatActivity, chatActivitySettings.RoleAssignmentMode)
+		if err != nil {
+			log.WithContext(ctx).WithFields(log.Fields{
+				"guild_id":  guildId,
+				"member_id": userId,
+			}).WithError(err).Error("Failed to assign activity roles.")
+		}
+	}
 
+	return &u.ActivityGrant{MemberProfile: updated, ProfileCreated: created}, nil
+}
+
+// assignActivityRoles adds the activity roles the member has earned, removing the lower ones when the mode is "replace".
+// Roles that fail to be assigned are logged and left out of the changes, since the points have already been granted.
+func (uc *MemberUsecase) assignActivityRoles(ctx context.Context, guildId string, userId string, activity u.MemberActivity, mode string) (*u.MemberRoleChanges, error) {
+	member, err := uc.d.GuildMember(ctx, guildId, userId)
 	if err != nil {
-		var pqErr *pq.Error
-		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
-			return nil, u.ErrMemberProfileExists
+		return nil, err
+	}
+
+	// Members that left are cached as null, so they don't come back as an error.
+	if member == nil {
+		return nil, u.ErrMemberNotInGuild
+	}
+
+	wanted := activity.CurrentActivityRoleIds
+	var unwanted []string
+	if mode == "replace" {
+		if activity.CurrentActivityRole == nil {
+			wanted = []string{}
+		} else {
+			wanted = []string{activity.CurrentActivityRole.RoleID}
 		}
 
-		return nil, err
+		for _, roleId := range activity.CurrentActivityRoleIds {
+			if !slices.Contains(wanted, roleId) {
+				unwanted = append(unwanted, roleId)
+			}
+		}
 	}
 
-	return uc.GetMemberProfile(ctx, guildId, userId)
+	changes := &u.MemberRoleChanges{
+		Added:   make([]string, 0),
+		Removed: make([]string, 0),
+	}
+
+	for _, roleId := range wanted {
+		if slices.Contains(member.Roles, roleId) {
+			continue
+		}
+
+		if err := uc.d.Session.GuildMemberRoleAdd(guildId, userId, roleId, discordgo.WithContext(ctx)); err != nil {
+			log.WithContext(ctx).WithFields(log.Fields{
+				"guild_id":  guildId,
+				"member_id": userId,
+				"role_id":   roleId,
+			}).WithError(err).Warn("Failed to add activity role.")
+			continue
+		}
+
+		changes.Added = append(changes.Added, roleId)
+	}
+
+	for _, roleId := range unwanted {
+		if !slices.Contains(member.Roles, roleId) {
+			continue
+		}
+
+		if err := uc.d.Session.GuildMemberRoleRemove(guildId, userId, roleId, discordgo.WithContext(ctx)); err != nil {
+			log.WithContext(ctx).WithFields(log.Fields{
+				"guild_id":  guildId,
+				"member_id": userId,
+				"role_id":   roleId,
+			}).WithError(err).Warn("Failed to remove activity role.")
+			continue
+		}
+
+		changes.Removed = append(changes.Removed, roleId)
+	}
+
+	return changes, nil
 }
 
-func (uc *MemberUsecase) GetMemberProfile(ctx context.Context, guildId string, userId string) (*u.MemberProfile, error) {
-	guildMember, err := uc.d.GuildMember(ctx, guildId, userId)
+// checkGrantDenyRoles makes sure the member doesn't have any roles that are denied from earning activity points.
+func (uc *MemberUsecase) checkGrantDenyRoles(ctx context.Context, guildId string, userId string, denyRoles []string) error {
+	member, err := uc.d.GuildMember(ctx, guildId, userId)
 	if err != nil {
 		var dgErr *discordgo.RESTError
 		if errors.As(err, &dgErr) && dgErr.Message.Code == discordgo.ErrCodeUnknownMember {
-			return nil, u.ErrMemberNotInGuild
+			return u.ErrMemberNotInGuild
 		}
 
-		return nil, err
+		return err
 	}
 
-	chatActivitySettings, err := uc.q.GetGuildChatActivitySettings(ctx, guildId)
+	// Members that left are cached as null, so they don't come back as an error.
+	if member == nil {
+		return u.ErrMemberNotInGuild
+	}
+
+	for _, roleId := range member.Roles {
+		if slices.Contains(denyRoles, roleId) {
+			return u.ErrMemberGrantDenied
+		}
+	}
+
+	return nil
+}
+
+func (uc *MemberUsecase) IncrementMemberVoiceActivityPoints(ctx context.Context, guildId string, userId string, createProfile *bool) (*u.ActivityGrant, error) {
+	voiceActivitySettings, err := uc.q.GetGuildVoiceActivitySettings(ctx, guildId)
 	if err != nil {
 		return nil, err
 	}
 
+	if !voiceActivitySettings.IsEnabled {
+		return nil, u.ErrVoiceActivityTrackingDisabled
+	}
+
+	// This also makes sure the member is still in the guild, so profiles aren't created for members that left.
+	if err := uc.checkGrantDenyRoles(ctx, guildId, userId, voiceActivitySettings.DenyRoles); err != nil {
+		return nil, err
+	}
+
+	createMissingProfile := shouldCreateProfile(voiceActivitySettings.AutoCreateProfiles, createProfile)
 	profile, err := uc.q.GetMemberProfile(ctx, db.GetMemberProfileParams{
 		GuildID:  guildId,
 		MemberID: userId,
 	})
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if err != sql.ErrNoRows {
+			return nil, err
+		}
+
+		if !createMissingProfile {
 			return nil, u.ErrMemberProfileNotFound
 		}
+	} else if remaining := cooldownRemaining(profile.LastVoiceActivityGrant, voiceActivitySettings.GrantCooldown, time.Now()); remaining > 0 {
+		// This only skips the work below for members that are clearly on cooldown, the grant itself enforces it.
+		return nil, u.NewCooldownError(remaining)
+	}
 
+	boost, err := uc.activityBoostMultiplier(ctx, guildId, userId, "voice")
+	if err != nil {
 		return nil, err
 	}
 
-	activityInfo, err := uc.q.GetMemberChatActivityRoleInfo(ctx, db.GetMemberChatActivityRoleInfoParams{
-		GuildID: guildId,
-		Points:  profile.ChatActivity,
-	})
-	if err != nil && err != sql.ErrNoRows {
+	points := int32(math.Round(float64(voiceActivitySettings.GrantAmount) * float64(boost)))
+	created, err := uc.incrementActivityPoints(ctx, guildId, userId, "voice", "", points, voiceActivitySettings.GrantCooldown, createMissingProfile)
+	if err != nil {
 		return nil, err
 	}
 
-	lastGrant := time.Unix(int64(profile.LastChatActivityGrant), 0)
-	nextGrant := lastGrant.Add(time.Duration(chatActivitySettings.GrantCooldown) * time.Second)
+	updated, err := uc.GetMemberProfile(ctx, guildId, userId)
+	if err != nil {
+		return nil, err
+	}
 
-	profileInfo := &u.MemberProfile{
-		DisplayName: guildMember.DisplayName(),
-		Username:    guildMember.User.Username,
-		AvatarURL:   guildMember.AvatarURL("100"),
+	// The points have already been granted, so the grant still succeeds when the roles can't be assigned.
+	if voiceActivitySettings.AutoAssignRoles {
+		updated.RoleChanges, err = uc.assignActivityRoles(ctx, guildId, userId, updated.VoiceActivity, voiceActivitySettings.RoleAssignmentMode)
+		if err != nil {
+			log.WithContext(ctx).WithFields(log.Fields{
+				"guild_id":  guildId,
+				"member_id": userId,
+			}).WithError(err).Error("Failed to assign activity roles.")
+		}
+	}
 
-		CardStyle: int32(profile.CardStyle),
-		ChatActivity: u.MemberActivity{
-			Rank:           int32(profile.ChatActivityRank),
-			Points:         int32(profile.ChatActivity),
-			LastGrantEpoch: int64(profile.LastChatActivityGrant),
-			IsOnCooldown:   time.Now().Before(nextGrant),
+	return &u.ActivityGrant{MemberProfile: updated, ProfileCreated: created}, nil
+}
 
-			CurrentActivityRoleIds: make([]string, 0),
+func toVoiceSession(session db.GuildVoiceSession) *u.VoiceSession {
+	return &u.VoiceSession{
+		VoiceSessionState: u.VoiceSessionState{
+			ChannelID:  session.ChannelID,
+			IsMuted:    session.IsMuted,
+			IsDeafened: session.IsDeafened,
+			IsAlone:    session.IsAlone,
 		},
-	}
 
-	if activityInfo.CurrentRoleID.Valid {
-		role, err := uc.d.GuildRole(ctx, guildId, activityInfo.CurrentRoleID.String)
-		if err == nil {
-			profileInfo.ChatActivity.CurrentActivityRole = &u.MemberActivityRole{
-				RoleID:         role.ID,
-				Name:           role.Name,
-				Accent:         fmt.Sprintf("#%06X", role.Color),
-				RequiredPoints: int32(activityInfo.CurrentRoleRequiredPoints.Int32),
-			}
-		}
+		StartedAt:     int64(session.StartedAt),
+		LastUpdate:    int64(session.LastUpdate),
+		ActiveSeconds: session.ActiveSeconds,
 	}
+}
 
-	if activityInfo.NextRoleID.Valid {
-		profileInfo.ChatActivity.NextActivityRole = &u.MemberActivityProgress{
-			CurrentProgress:  profile.ChatActivity - activityInfo.CurrentRoleRequiredPoints.Int32,
-			RequiredProgress: activityInfo.NextRoleRequiredPoints.Int32 - activityInfo.CurrentRoleRequiredPoints.Int32,
+func (uc *MemberUsecase) GetMemberVoiceSession(ctx context.Context, guildId string, userId string) (*u.VoiceSession, error) {
+	session, err := uc.q.GetVoiceSession(ctx, db.GetVoiceSessionParams{
+		GuildID:  guildId,
+		MemberID: userId,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, u.ErrVoiceSessionNotFound
 		}
-	}
 
-	if len(activityInfo.CurrentRolesIds) > 0 {
-		profileInfo.ChatActivity.CurrentActivityRoleIds = activityInfo.CurrentRolesIds
+		return nil, err
 	}
 
-	return profileInfo, nil
+	return toVoiceSession(session), nil
 }
 
-func (uc *MemberUsecase) IncrementMemberChatActivityPoints(ctx context.Context, guildId string, userId string) (*u.MemberProfile, error) {
-	chatActivitySettings, err := uc.q.GetGuildChatActivitySettings(ctx, guildId)
+func (uc *MemberUsecase) OpenMemberVoiceSession(ctx context.Context, guildId string, userId string, state u.VoiceSessionState, createProfile *bool) (*u.VoiceSession, error) {
+	voiceActivitySettings, err := uc.q.GetGuildVoiceActivitySettings(ctx, guildId)
 	if err != nil {
 		return nil, err
 	}
 
-	if !chatActivitySettings.IsEnabled {
-		return nil, u.ErrChatActivityTrackingDisabled
+	if !voiceActivitySettings.IsEnabled {
+		return nil, u.ErrVoiceActivityTrackingDisabled
 	}
 
-	profile, err := uc.q.GetMemberProfile(ctx, db.GetMemberProfileParams{
+	// This also makes sure the member is still in the guild, so profiles aren't created for members that left.
+	if err := uc.checkGrantDenyRoles(ctx, guildId, userId, voiceActivitySettings.DenyRoles); err != nil {
+		return nil, err
+	}
+
+	_, err = uc.q.GetMemberProfile(ctx, db.GetMemberProfileParams{
 		GuildID:  guildId,
 		MemberID: userId,
 	})
 	if err != nil {
-		if err == sql.ErrNoRows {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+
+		if !shouldCreateProfile(voiceActivitySettings.AutoCreateProfiles, createProfile) {
 			return nil, u.ErrMemberProfileNotFound
 		}
 
-		return nil, err
+		// The session's points are granted to the profile when it's closed, so it has to exist before the session is opened.
+		_, err := uc.q.EnsureMemberProfile(ctx, db.EnsureMemberProfileParams{
+			GuildID:  guildId,
+			MemberID: userId,
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	tx, err := uc.db.BeginTx(ctx, nil)
+	session, err := uc.q.OpenVoiceSession(ctx, db.OpenVoiceSessionParams{
+		GuildID:    guildId,
+		MemberID:   userId,
+		ChannelID:  state.ChannelID,
+		IsMuted:    state.IsMuted,
+		IsDeafened: state.IsDeafened,
+		IsAlone:    state.IsAlone,
+	})
 	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return nil, u.ErrVoiceSessionExists
+		}
+
 		return nil, err
 	}
-	q := uc.q.WithTx(tx)
 
-	lastGrant := time.Unix(int64(profile.LastChatActivityGrant), 0)
-	nextGrant := lastGrant.Add(time.Duration(chatActivitySettings.GrantCooldown) * time.Second)
-	if time.Now().Before(nextGrant) {
-		return nil, u.ErrMemberOnGrantCooldown
+	return toVoiceSession(session), nil
+}
+
+// UpdateMemberVoiceSession accrues the time since the last update and replaces the session state.
+// This should also be called periodically with the same state, since sessions that stop being updated are treated as orphaned.
+func (uc *MemberUsecase) UpdateMemberVoiceSession(ctx context.Context, guildId string, userId string, state u.VoiceSessionState) (*u.VoiceSession, error) {
+	session, err := uc.q.UpdateVoiceSession(ctx, db.UpdateVoiceSessionParams{
+		GuildID:    guildId,
+		MemberID:   userId,
+		ChannelID:  state.ChannelID,
+		IsMuted:    state.IsMuted,
+		IsDeafened: state.IsDeafened,
+		IsAlone:    state.IsAlone,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, u.ErrVoiceSessionNotFound
+		}
+
+		return nil, err
 	}
 
-	_, err = q.IncrememberMemberChatActivityPoints(ctx, db.IncrememberMemberChatActivityPointsParams{
-		GuildID:  guildId,
-		MemberID: userId,
+	return toVoiceSession(session), nil
+}
 
-		Points: chatActivitySettings.GrantAmount,
-	})
+// CloseMemberVoiceSession closes the session and grants voice activity points for every full minute the member was active.
+func (uc *MemberUsecase) CloseMemberVoiceSession(ctx context.Context, guildId string, userId string) (*u.ClosedVoiceSession, error) {
+	voiceActivitySettings, err := uc.q.GetGuildVoiceActivitySettings(ctx, guildId)
 	if err != nil {
-		_ = tx.Rollback()
 		return nil, err
 	}
 
-	err = q.IncrementWeeklyActivityLeaderboard(ctx, db.IncrementWeeklyActivityLeaderboardParams{
-		GrantType:    "chat",
-		GuildID:      guildId,
-		MemberID:     userId,
-		EarnedPoints: chatActivitySettings.GrantAmount,
-	})
+	boost, err := uc.activityBoostMultiplier(ctx, guildId, userId, "voice")
 	if err != nil {
-		_ = tx.Rollback()
 		return nil, err
 	}
 
-	err = q.IncrementMonthlyActivityLeaderboard(ctx, db.IncrementMonthlyActivityLeaderboardParams{
-		GrantType:    "chat",
-		GuildID:      guildId,
-		MemberID:     userId,
-		EarnedPoints: chatActivitySettings.GrantAmount,
+	tx, err := uc.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	q := uc.q.InTx(tx)
+
+	session, err := q.CloseVoiceSession(ctx, db.CloseVoiceSessionParams{
+		GuildID:  guildId,
+		MemberID: userId,
+		ClosedAt: int32(time.Now().Unix()),
 	})
 	if err != nil {
 		_ = tx.Rollback()
+
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, u.ErrVoiceSessionNotFound
+		}
+
 		return nil, err
 	}
 
-	err = tx.Commit()
-	if err != nil {
+	var points int32
+	if voiceActivitySettings.IsEnabled {
+		points = int32(math.Round(float64((session.ActiveSeconds/60)*voiceActivitySettings.GrantAmount) * float64(boost)))
+	}
+
+	if points > 0 {
+		// The session's time is granted all at once, so the grant cooldown doesn't apply.
+		err = db.GrantActivityPoints(ctx, q, guildId, userId, "voice", session.ChannelID, points, 0)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			_ = tx.Rollback()
+			return nil, err
+		}
+
+		// The profile was removed while the session was open, so there's nothing to grant to.
+		if errors.Is(err, sql.ErrNoRows) {
+			points = 0
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
-	// TODO:
-	// This increases the return time for this request. We do similar fetches (outside of roles) in the method used below.
-	//
-	// It would be better to have some sort of structure that can have its data set and return the new structure
-	// instead of fetching everything again.
-	return uc.GetMemberProfile(ctx, guildId, userId)
+	if points > 0 {
+		bumpCardDataVersion(ctx, uc.c, guildId)
+	}
+
+	return &u.ClosedVoiceSession{
+		ChannelID:     session.ChannelID,
+		StartedAt:     int64(session.StartedAt),
+		ActiveSeconds: session.ActiveSeconds,
+		PointsGranted: points,
+	}, nil
+}
+
+// activityLayout converts the member's activity into the layout used by the profile card.
+func activityLayout(activity u.MemberActivity, rankings db.GetActivityLeaderboardRankingsRow) layouts.ActivityInfo {
+	var weeklyRank int
+	var monthlyRank int
+	if rankings.WeeklyLeaderboardRank.Valid {
+		weeklyRank = int(rankings.WeeklyLeaderboardRank.Int32)
+	}
+	if rankings.MonthlyLeaderboardRank.Valid {
+		monthlyRank = int(rankings.MonthlyLeaderboardRank.Int32)
+	}
+
+	info := layouts.ActivityInfo{
+		Ranking: layouts.RankingInfo{
+			AllTime: int(activity.Rank),
+			Weekly:  weeklyRank,
+			Monthly: monthlyRank,
+		},
+		TotalPoints: int(activity.Points),
+	}
+
+	if activity.CurrentActivityRole != nil {
+		info.CurrentTitleInfo = &layouts.ActivityRole{
+			Accent: activity.CurrentActivityRole.Accent,
+			Text:   activity.CurrentActivityRole.Name,
+		}
+	}
+
+	if activity.NextActivityRole != nil {
+		info.RoleCurrentPoints = int(activity.NextActivityRole.CurrentProgress)
+		info.RoleRequiredPoints = int(activity.NextActivityRole.RequiredProgress)
+	}
+
+	return info
+}
+
+// The amount of past weeks looked at for the streak shown on the profile card.
+const profileCardStreakWeeks = 52
+
+// activityStreak gets the member's weekly streak and trend for the profile card.
+func (uc *MemberUsecase) activityStreak(ctx context.Context, guildId string, userId string, activityType string) (layouts.ActivityStreakProps, error) {
+	history, err := uc.GetMemberActivityHistory(ctx, guildId, userId, activityType, profileCardStreakWeeks, 0)
+	if err != nil {
+		return layouts.ActivityStreakProps{}, err
+	}
+
+	return layouts.ActivityStreakProps{
+		Weeks: int(history.WeeklyStreak),
+		Trend: history.WeeklyTrend,
+	}, nil
 }
 
-func (uc *MemberUsecase) GenerateMemberProfileCard(ctx context.Context, guildId string, userId string) (gomponents.Node, error) {
+// profileCardProps gets everything shown on the member's profile card.
+func (uc *MemberUsecase) profileCardProps(ctx context.Context, guildId string, userId string) (*layouts.ProfileCardProps, error) {
 	profile, err := uc.GetMemberProfile(ctx, guildId, userId)
 	if err != nil {
 		return nil, err
@@ -230,41 +1104,45 @@ func (uc *MemberUsecase) GenerateMemberProfileCard(ctx context.Context, guildId
 		return nil, err
 	}
 
-	var weeklyRank int
-	var monthlyRank int
-	if chatRankings.WeeklyLeaderboardRank.Valid {
-		weeklyRank = int(chatRankings.WeeklyLeaderboardRank.Int32)
-	}
-	if chatRankings.MonthlyLeaderboardRank.Valid {
-		monthlyRank = int(chatRankings.MonthlyLeaderboardRank.Int32)
+	chatStreak, err := uc.activityStreak(ctx, guildId, userId, "chat")
+	if err != nil {
+		return nil, err
 	}
 
 	layout := layouts.ProfileCardProps{
 		CardStyle: profile.CardStyle,
 
-		DisplayName: profile.DisplayName,
-		Username:    profile.Username,
-		AvatarURL:   profile.AvatarURL,
-		ChatActivity: layouts.ActivityInfo{
-			Ranking: layouts.RankingInfo{
-				AllTime: int(profile.ChatActivity.Rank),
-				Weekly:  weeklyRank,
-				Monthly: monthlyRank,
-			},
-			TotalPoints: int(profile.ChatActivity.Points),
-		},
+		DisplayName:  profile.DisplayName,
+		Username:     profile.Username,
+		AvatarURL:    profile.AvatarURL,
+		ChatActivity: activityLayout(profile.ChatActivity, chatRankings),
 	}
+	layout.ChatActivity.Streak = chatStreak
 
-	if profile.ChatActivity.CurrentActivityRole != nil {
-		layout.ChatActivity.CurrentTitleInfo = &layouts.ActivityRole{
-			Accent: profile.ChatActivity.CurrentActivityRole.Accent,
-			Text:   profile.ChatActivity.CurrentActivityRole.Name,
-		}
+	voiceActivitySettings, err := uc.q.GetGuildVoiceActivitySettings(ctx, guildId)
+	if err != nil {
+		return nil, err
 	}
 
-	if profile.ChatActivity.NextActivityRole != nil {
-		layout.ChatActivity.RoleCurrentPoints = int(profile.ChatActivity.NextActivityRole.CurrentProgress)
-		layout.ChatActivity.RoleRequiredPoints = int(profile.ChatActivity.NextActivityRole.RequiredProgress)
+	// Voice activity is only shown when it's being tracked or the member has earned points from it before.
+	if voiceActivitySettings.IsEnabled || profile.VoiceActivity.Points > 0 {
+		voiceRankings, err := uc.q.GetActivityLeaderboardRankings(ctx, db.GetActivityLeaderboardRankingsParams{
+			GuildID:   guildId,
+			MemberID:  userId,
+			GrantType: "voice",
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		voiceStreak, err := uc.activityStreak(ctx, guildId, userId, "voice")
+		if err != nil {
+			return nil, err
+		}
+
+		voiceActivity := activityLayout(profile.VoiceActivity, voiceRankings)
+		voiceActivity.Streak = voiceStreak
+		layout.VoiceActivity = &voiceActivity
 	}
 
 	// These set overrides based on the user profile.
@@ -275,7 +1153,114 @@ func (uc *MemberUsecase) GenerateMemberProfileCard(ctx context.Context, guildId
 		}
 	}
 
-	return layouts.ProfileCard(layout), nil
+	return &layout, nil
+}
+
+func profileCardCacheKey(guildId string, userId string, format string) func(version int64) string {
+	return func(version int64) string {
+		return renderedCardCacheKey("profile", guildId, userId, format, version)
+	}
+}
+
+func (uc *MemberUsecase) GetMemberProfileCardETag(ctx context.Context, guildId string, userId string, format string) (string, error) {
+	if _, err := parseCardFormat(uc.r, format); err != nil {
+		return "", err
+	}
+
+	return versionedCardETag(ctx, uc.c, guildId, profileCardCacheKey(guildId, userId, format)), nil
+}
+
+func (uc *MemberUsecase) GenerateMemberProfileCard(ctx context.Context, guildId string, userId string, format string) (*u.RenderedCard, error) {
+	imageFormat, err := parseCardFormat(uc.r, format)
+	if err != nil {
+		return nil, err
+	}
+
+	return getVersionedCard(ctx, uc.c, guildId, profileCardCacheKey(guildId, userId, format), func(ctx context.Context) (*u.RenderedCard, error) {
+		props, err := uc.profileCardProps(ctx, guildId, userId)
+		if err != nil {
+			return nil, err
+		}
+
+		return renderCard(ctx, uc.r, uc.c, "profile", imageFormat, props, func() (gomponents.Node, renderer.Page) {
+			return layouts.ProfileCard(*props), renderer.Page{
+				Selector: "#root",
+				Title:    props.DisplayName,
+				Lines:    profileCardText(props),
+			}
+		})
+	})
+}
+
+// profileCardText is the plain text version of the profile card.
+func profileCardText(props *layouts.ProfileCardProps) []string {
+	lines := []string{
+		fmt.Sprintf("@%s", props.Username),
+		activityText("Chat", props.ChatActivity),
+	}
+
+	if props.VoiceActivity != nil {
+		lines = append(lines, activityText("Voice", *props.VoiceActivity))
+	}
+
+	return lines
+}
+
+func activityText(activityType string, info layouts.ActivityInfo) string {
+	text := fmt.Sprintf("%s: %d points, #%d all time", activityType, info.TotalPoints, info.Ranking.AllTime)
+	if info.Streak.Weeks > 0 {
+		text += fmt.Sprintf(", %d week streak", info.Streak.Weeks)
+	}
+	if info.CurrentTitleInfo != nil && info.CurrentTitleInfo.Text != "" {
+		text += fmt.Sprintf(" (%s)", info.CurrentTitleInfo.Text)
+	}
+
+	return text
+}
+
+// recordProfileMigration records the migrated points in the ledgers of both members.
+// The member that was migrated to gets a "migration" entry, and the member that was migrated from gets a "reset" entry.
+func recordProfileMigration(ctx context.Context, q db.Querier, guildId string, userId string, toUserId string, profile db.GetMemberProfileRow) error {
+	migrated := []struct {
+		grantType string
+		points    int32
+	}{
+		{grantType: "chat", points: profile.ChatActivity},
+		{grantType: "voice", points: profile.VoiceActivity},
+	}
+
+	for _, activity := range migrated {
+		if activity.points == 0 {
+			continue
+		}
+
+		entries := []db.InsertActivityLedgerEntryParams{
+			{
+				GuildID:   guildId,
+				MemberID:  toUserId,
+				GrantType: activity.grantType,
+				Source:    "migration",
+				Amount:    activity.points,
+				Reference: userId,
+			},
+			{
+				GuildID:   guildId,
+				MemberID:  userId,
+				GrantType: activity.grantType,
+				Source:    "reset",
+				Amount:    -activity.points,
+				Reference: toUserId,
+			},
+		}
+
+		for _, entry := range entries {
+			if err := q.InsertActivityLedgerEntry(ctx, entry); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
 }
 
 func (uc *MemberUsecase) MigrateMemberProfile(ctx context.Context, guildId string, userId string, toUserId string) error {
@@ -283,7 +1268,7 @@ func (uc *MemberUsecase) MigrateMemberProfile(ctx context.Context, guildId strin
 	if err != nil {
 		return err
 	}
-	q := uc.q.WithTx(tx)
+	q := uc.q.InTx(tx)
 
 	// Fetch the existing profile to migrate from.
 	profile, err := q.GetMemberProfile(ctx, db.GetMemberProfileParams{
@@ -330,10 +1315,17 @@ func (uc *MemberUsecase) MigrateMemberProfile(ctx context.Context, guildId strin
 		return err
 	}
 
+	err = recordProfileMigration(ctx, q, guildId, userId, toUserId, profile)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return err
 	}
 
+	bumpCardDataVersion(ctx, uc.c, guildId)
 	return nil
 }
@@ -0,0 +1,291 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	log "github.com/sirupsen/logrus"
+	"github.com/typical-developers/discord-bot-backend/internal/db"
+	u "github.com/typical-developers/discord-bot-backend/internal/usecase"
+)
+
+const (
+	// The amount of audit log entries shown on a single page.
+	settingsAuditLogPageSize = 50
+
+	// Discord doesn't allow embed field values longer than this.
+	maxAuditLogFieldLength = 1024
+)
+
+// voiceRoomLobbyAudit is the part of a voice room lobby that's recorded, the rooms opened from it aren't settings.
+type voiceRoomLobbyAudit struct {
+	UserLimit      int32 `json:"user_limit"`
+	CanRename      bool  `json:"can_rename"`
+	CanLock        bool  `json:"can_lock"`
+	CanAdjustLimit bool  `json:"can_adjust_limit"`
+}
+
+func toVoiceRoomLobbyAudit(lobby *u.VoiceRoomLobby) voiceRoomLobbyAudit {
+	return voiceRoomLobbyAudit{
+		UserLimit:      lobby.UserLimit,
+		CanRename:      lobby.CanRename,
+		CanLock:        lobby.CanLock,
+		CanAdjustLimit: lobby.CanAdjustLimit,
+	}
+}
+
+func toSettingsAuditLogEntry(entry db.GuildSettingsAuditLog) u.SettingsAuditLogEntry {
+	return u.SettingsAuditLogEntry{
+		EntryID:   entry.EntryID,
+		ActorID:   entry.ActorID,
+		Action:    entry.Action,
+		Target:    entry.Target,
+		Before:    entry.Before,
+		After:     entry.After,
+		CreatedAt: int64(entry.InsertEpoch),
+	}
+}
+
+// settingsFields gets the setting's JSON fields, there are none when the setting is nil.
+func settingsFields(setting any) (map[string]any, error) {
+	fields := map[string]any{}
+	if setting == nil {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(setting)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// settingsDiff gets the fields that are different between the two versions of a setting.
+// Either version can be nil when the setting was created or deleted, in which case every field of the other version is kept.
+func settingsDiff(before, after any) (map[string]any, map[string]any, error) {
+	beforeFields, err := settingsFields(before)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	afterFields, err := settingsFields(after)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for key, value := range beforeFields {
+		if other, ok := afterFields[key]; ok && reflect.DeepEqual(value, other) {
+			delete(beforeFields, key)
+			delete(afterFields, key)
+		}
+	}
+
+	return beforeFields, afterFields, nil
+}
+
+// recordSettingsChange records a change to the guild's settings in its audit log, attributed to the actor attached to the context.
+// Nothing is recorded when none of the fields changed.
+//
+// The change has already been made by the time it's recorded, so failures are logged instead of being returned.
+// The request's cancellation is ignored, so changes are still recorded when the client disconnects.
+func (uc *GuildUsecase) recordSettingsChange(ctx context.Context, guildId string, action string, target string, before, after any) {
+	ctx = context.WithoutCancel(ctx)
+	logger := log.WithContext(ctx).WithFields(log.Fields{
+		"guild_id": guildId,
+		"action":   action,
+	})
+
+	beforeFields, afterFields, err := settingsDiff(before, after)
+	if err != nil {
+		logger.WithError(err).Error("Failed to diff the settings change.")
+		return
+	}
+
+	if len(beforeFields) == 0 && len(afterFields) == 0 {
+		return
+	}
+
+	beforeJSON, err := json.Marshal(beforeFields)
+	if err != nil {
+		logger.WithError(err).Error("Failed to encode the settings change.")
+		return
+	}
+
+	afterJSON, err := json.Marshal(afterFields)
+	if err != nil {
+		logger.WithError(err).Error("Failed to encode the settings change.")
+		return
+	}
+
+	entry, err := uc.q.InsertSettingsAuditLogEntry(ctx, db.InsertSettingsAuditLogEntryParams{
+		GuildID: guildId,
+		ActorID: u.ActorID(ctx),
+		Action:  action,
+		Target:  target,
+		Before:  beforeJSON,
+		After:   afterJSON,
+	})
+	if err != nil {
+		logger.WithError(err).Error("Failed to record the settings change.")
+		return
+	}
+
+	uc.postSettingsChange(ctx, guildId, toSettingsAuditLogEntry(entry))
+}
+
+// auditLogField formats the changed fields for an embed, cutting them off when they're too long.
+func auditLogField(fields json.RawMessage) string {
+	indented, err := json.MarshalIndent(fields, "", "  ")
+	if err != nil {
+		indented = fields
+	}
+
+	const wrapper = "```json\n\n```"
+	value := string(indented)
+	if len(value)+len(wrapper) > maxAuditLogFieldLength {
+		// Cutting the value off can split a character, which Discord won't accept.
+		value = strings.ToValidUTF8(value[:maxAuditLogFieldLength-len(wrapper)-3], "") + "..."
+	}
+
+	return fmt.Sprintf("```json\n%s\n```", value)
+}
+
+// postSettingsChange posts the audit log entry to the guild's audit log channel, when it has one.
+func (uc *GuildUsecase) postSettingsChange(ctx context.Context, guildId string, entry u.SettingsAuditLogEntry) {
+	logger := log.WithContext(ctx).WithFields(log.Fields{
+		"guild_id": guildId,
+		"entry_id": entry.EntryID,
+	})
+
+	channelId, err := uc.q.GetGuildAuditLogChannel(ctx, guildId)
+	if err != nil {
+		logger.WithError(err).Error("Failed to get the audit log channel.")
+		return
+	}
+
+	if channelId == "" {
+		return
+	}
+
+	actor := "Unknown"
+	if entry.ActorID != "" {
+		actor = fmt.Sprintf("<@%s>", entry.ActorID)
+	}
+
+	fields := []*discordgo.MessageEmbedField{
+		{Name: "Actor", Value: actor, Inline: true},
+	}
+	if entry.Target != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{Name: "Target", Value: entry.Target, Inline: true})
+	}
+	fields = append(fields,
+		&discordgo.MessageEmbedField{Name: "Before", Value: auditLogField(entry.Before)},
+		&discordgo.MessageEmbedField{Name: "After", Value: auditLogField(entry.After)},
+	)
+
+	_, err = uc.d.Session.ChannelMessageSendComplex(channelId, &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{{
+			Title:     entry.Action,
+			Fields:    fields,
+			Timestamp: time.Unix(entry.CreatedAt, 0).UTC().Format(time.RFC3339),
+		}},
+		// The actor is only mentioned to show who they are, they shouldn't be pinged.
+		AllowedMentions: &discordgo.MessageAllowedMentions{},
+	}, discordgo.WithContext(ctx))
+	if err != nil {
+		logger.WithField("channel_id", channelId).WithError(err).Warn("Failed to post the settings change to the audit log channel.")
+	}
+}
+
+func (uc *GuildUsecase) GetSettingsAuditLog(ctx context.Context, guildId string, page int) (*u.GuildSettingsAuditLog, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	total, err := uc.q.CountSettingsAuditLog(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := uc.q.GetSettingsAuditLog(ctx, db.GetSettingsAuditLogParams{
+		GuildID:  guildId,
+		LimitBy:  settingsAuditLogPageSize,
+		OffsetBy: int32(page-1) * settingsAuditLogPageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]u.SettingsAuditLogEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, toSettingsAuditLogEntry(row))
+	}
+
+	totalPages := (total + settingsAuditLogPageSize - 1) / settingsAuditLogPageSize
+	return &u.GuildSettingsAuditLog{
+		CurrentPage: int32(page),
+		TotalPages:  totalPages,
+		HasNextPage: int32(page) < totalPages,
+		Entries:     entries,
+	}, nil
+}
+
+// UpdateAuditLogChannel sets the channel that the guild's settings changes are posted to, an empty channel ID stops them from being posted.
+func (uc *GuildUsecase) UpdateAuditLogChannel(ctx context.Context, guildId string, channelId string) (*u.GuildSettings, error) {
+	if channelId != "" {
+		channel, err := uc.d.Channel(ctx, channelId)
+		if err != nil {
+			var restErr *discordgo.RESTError
+			if errors.As(err, &restErr) && restErr.Response != nil && restErr.Response.StatusCode == 404 {
+				return nil, u.ErrAuditLogChannelNotFound
+			}
+
+			return nil, err
+		}
+
+		if channel.GuildID != guildId {
+			return nil, u.ErrAuditLogChannelNotFound
+		}
+	}
+
+	previous, err := uc.q.GetGuildAuditLogChannel(ctx, guildId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, u.ErrGuildNotFound
+		}
+
+		return nil, err
+	}
+
+	_, err = uc.q.UpdateGuildAuditLogChannel(ctx, db.UpdateGuildAuditLogChannelParams{
+		GuildID:           guildId,
+		AuditLogChannelID: channelId,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, u.ErrGuildNotFound
+		}
+
+		return nil, err
+	}
+
+	uc.invalidateGuildSettings(ctx, guildId)
+	uc.recordSettingsChange(ctx, guildId, "audit_log_channel.update", "",
+		map[string]string{"channel_id": previous},
+		map[string]string{"channel_id": channelId},
+	)
+
+	return uc.GetGuildSettings(ctx, guildId)
+}
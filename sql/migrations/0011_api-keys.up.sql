@@ -0,0 +1,18 @@
+-- API keys used to authenticate requests made to the API.
+--
+-- The key itself is never stored, only a SHA-256 hash of it.
+-- The AUTH_KEY environment variable still acts as a master key with every scope.
+
+CREATE TABLE IF NOT EXISTS api_keys (
+    insert_epoch INT NOT NULL DEFAULT EXTRACT (EPOCH FROM now() AT TIME ZONE 'utc'),
+    key_id TEXT NOT NULL,
+    key_hash TEXT NOT NULL,
+    name TEXT NOT NULL DEFAULT '',
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+
+    -- A NULL expiry means the key never expires.
+    expires_at INT,
+
+    PRIMARY KEY (key_id),
+    UNIQUE (key_hash)
+);
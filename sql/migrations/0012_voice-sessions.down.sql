@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS guild_voice_sessions_last_update_index;
+DROP TABLE IF EXISTS guild_voice_sessions;
@@ -0,0 +1,20 @@
+-- Voice activity is accrued over the time a member spends in a voice channel.
+--
+-- A session is opened when the member joins a channel and closed when they leave.
+-- Time is only counted while the member is unmuted, undeafened and not alone in the channel.
+CREATE TABLE IF NOT EXISTS guild_voice_sessions (
+    guild_id TEXT NOT NULL REFERENCES guilds (guild_id) ON DELETE CASCADE,
+    member_id TEXT NOT NULL,
+    channel_id TEXT NOT NULL,
+    started_at INT NOT NULL DEFAULT EXTRACT (EPOCH FROM now() AT TIME ZONE 'utc'),
+    last_update INT NOT NULL DEFAULT EXTRACT (EPOCH FROM now() AT TIME ZONE 'utc'),
+    active_seconds INT NOT NULL DEFAULT 0,
+    is_muted BOOLEAN NOT NULL DEFAULT FALSE,
+    is_deafened BOOLEAN NOT NULL DEFAULT FALSE,
+    is_alone BOOLEAN NOT NULL DEFAULT FALSE,
+
+    PRIMARY KEY (guild_id, member_id)
+);
+
+CREATE INDEX IF NOT EXISTS guild_voice_sessions_last_update_index
+ON guild_voice_sessions (last_update);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS guild_chat_activity_channel_multipliers;
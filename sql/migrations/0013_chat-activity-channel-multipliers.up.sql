@@ -0,0 +1,11 @@
+-- Multipliers applied to chat activity grants based on the channel the message was sent in.
+--
+-- The channel can also be a category, which applies to every channel under it.
+-- Channel multipliers take priority over category multipliers, and a multiplier of 0 excludes the channel entirely.
+CREATE TABLE IF NOT EXISTS guild_chat_activity_channel_multipliers (
+    guild_id TEXT NOT NULL REFERENCES guilds (guild_id) ON DELETE CASCADE,
+    channel_id TEXT NOT NULL,
+    multiplier REAL NOT NULL DEFAULT 1 CHECK (multiplier >= 0),
+
+    PRIMARY KEY (guild_id, channel_id)
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS guild_activity_boosts_guild_id_index;
+DROP TABLE IF EXISTS guild_activity_boosts;
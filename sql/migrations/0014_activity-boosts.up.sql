@@ -0,0 +1,23 @@
+-- Scheduled boosts that multiply activity grants for a period of time, for example: double XP weekends.
+--
+-- When role IDs are set, only members with at least one of the roles are boosted.
+CREATE TABLE IF NOT EXISTS guild_activity_boosts (
+    insert_epoch INT DEFAULT EXTRACT (EPOCH FROM now() AT TIME ZONE 'utc'),
+    boost_id SERIAL NOT NULL,
+    guild_id TEXT NOT NULL REFERENCES guilds (guild_id) ON DELETE CASCADE,
+    name TEXT NOT NULL DEFAULT '',
+    grant_type TEXT NOT NULL CHECK (grant_type IN ('chat', 'voice')),
+    multiplier REAL NOT NULL CHECK (multiplier > 0),
+    role_ids TEXT[] NOT NULL DEFAULT '{}',
+    starts_at INT NOT NULL,
+    ends_at INT NOT NULL,
+
+    -- Used by the cron service to log when the boost starts.
+    has_started BOOLEAN NOT NULL DEFAULT FALSE,
+
+    PRIMARY KEY (boost_id),
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS guild_activity_boosts_guild_id_index
+ON guild_activity_boosts (guild_id, grant_type, starts_at, ends_at);
@@ -0,0 +1,7 @@
+ALTER TABLE guild_chat_activity_settings
+DROP COLUMN IF EXISTS auto_assign_roles,
+DROP COLUMN IF EXISTS role_assignment_mode;
+
+ALTER TABLE guild_voice_activity_settings
+DROP COLUMN IF EXISTS auto_assign_roles,
+DROP COLUMN IF EXISTS role_assignment_mode;
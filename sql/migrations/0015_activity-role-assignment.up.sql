@@ -0,0 +1,11 @@
+-- Allows the backend to assign activity roles itself after a successful grant.
+--
+-- "stack" keeps every activity role the member has earned.
+-- "replace" only keeps the highest activity role and removes the lower ones.
+ALTER TABLE guild_chat_activity_settings
+ADD COLUMN auto_assign_roles BOOLEAN NOT NULL DEFAULT FALSE,
+ADD COLUMN role_assignment_mode TEXT NOT NULL DEFAULT 'stack' CHECK (role_assignment_mode IN ('stack', 'replace'));
+
+ALTER TABLE guild_voice_activity_settings
+ADD COLUMN auto_assign_roles BOOLEAN NOT NULL DEFAULT FALSE,
+ADD COLUMN role_assignment_mode TEXT NOT NULL DEFAULT 'stack' CHECK (role_assignment_mode IN ('stack', 'replace'));
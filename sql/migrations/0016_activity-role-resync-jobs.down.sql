@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS guild_activity_role_resync_jobs_active_index;
+DROP TABLE IF EXISTS guild_activity_role_resync_jobs;
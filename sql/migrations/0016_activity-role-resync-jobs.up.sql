@@ -0,0 +1,25 @@
+-- Jobs that resync every member's activity roles in a guild, processed in batches by the cron service.
+--
+-- `last_member_id` is the cursor for the next batch and `locked_until` prevents overlapping runs from processing the same job.
+CREATE TABLE IF NOT EXISTS guild_activity_role_resync_jobs (
+    insert_epoch INT NOT NULL DEFAULT EXTRACT (EPOCH FROM now() AT TIME ZONE 'utc'),
+    job_id SERIAL NOT NULL,
+    guild_id TEXT NOT NULL REFERENCES guilds (guild_id) ON DELETE CASCADE,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'completed', 'failed')),
+    total_profiles INT NOT NULL DEFAULT 0,
+    processed_profiles INT NOT NULL DEFAULT 0,
+    roles_added INT NOT NULL DEFAULT 0,
+    roles_removed INT NOT NULL DEFAULT 0,
+    last_member_id TEXT NOT NULL DEFAULT '',
+    error TEXT NOT NULL DEFAULT '',
+    started_at INT,
+    finished_at INT,
+    locked_until INT,
+
+    PRIMARY KEY (job_id)
+);
+
+-- Only one job can be queued or running for a guild at a time.
+CREATE UNIQUE INDEX IF NOT EXISTS guild_activity_role_resync_jobs_active_index
+ON guild_activity_role_resync_jobs (guild_id)
+WHERE status IN ('pending', 'running');
@@ -0,0 +1,5 @@
+ALTER TABLE guild_chat_activity_settings
+DROP COLUMN IF EXISTS auto_create_profiles;
+
+ALTER TABLE guild_voice_activity_settings
+DROP COLUMN IF EXISTS auto_create_profiles;
@@ -0,0 +1,7 @@
+-- Allows activity grants to create the member's profile when it doesn't exist yet,
+-- instead of the bot having to create the profile and retry the grant.
+ALTER TABLE guild_chat_activity_settings
+ADD COLUMN auto_create_profiles BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE guild_voice_activity_settings
+ADD COLUMN auto_create_profiles BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS guild_activity_adjustments_member_index;
+DROP TABLE IF EXISTS guild_activity_adjustments;
@@ -0,0 +1,23 @@
+-- Manual changes to members' activity points, i.e. awarding event prizes or removing points earned by spamming.
+--
+-- Every member that's adjusted gets their own row, with the points before and after the adjustment.
+-- `actor_id` is the user that made the adjustment.
+CREATE TABLE IF NOT EXISTS guild_activity_adjustments (
+    insert_epoch INT NOT NULL DEFAULT EXTRACT (EPOCH FROM now() AT TIME ZONE 'utc'),
+    adjustment_id SERIAL NOT NULL,
+    guild_id TEXT NOT NULL REFERENCES guilds (guild_id) ON DELETE CASCADE,
+    member_id TEXT NOT NULL,
+    grant_type TEXT NOT NULL CHECK (grant_type IN ('chat', 'voice')),
+    operation TEXT NOT NULL CHECK (operation IN ('add', 'subtract', 'set')),
+    amount INT NOT NULL CHECK (amount >= 0),
+    previous_points INT NOT NULL,
+    new_points INT NOT NULL,
+    adjusted_leaderboards BOOLEAN NOT NULL DEFAULT FALSE,
+    reason TEXT NOT NULL,
+    actor_id TEXT NOT NULL,
+
+    PRIMARY KEY (adjustment_id)
+);
+
+CREATE INDEX IF NOT EXISTS guild_activity_adjustments_member_index
+ON guild_activity_adjustments (guild_id, member_id, adjustment_id DESC);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS guild_activity_ledger_insert_epoch_index;
+DROP INDEX IF EXISTS guild_activity_ledger_member_index;
+DROP TABLE IF EXISTS guild_activity_ledger;
@@ -0,0 +1,38 @@
+-- An append-only record of every change to members' activity points.
+--
+-- The source is where the change came from:
+-- "grant" for activity grants, "adjustment" for manual adjustments, "migration" and "reset" for migrated profiles,
+-- and "compacted" for the total of older entries that have been compacted by the cron service.
+--
+-- `channel_id` is empty when the change isn't tied to a channel, i.e. adjustments and voice grants.
+-- `reference` is the other member for migrations and resets, and the adjustment's ID for adjustments.
+CREATE TABLE IF NOT EXISTS guild_activity_ledger (
+    insert_epoch INT NOT NULL DEFAULT EXTRACT (EPOCH FROM now() AT TIME ZONE 'utc'),
+    entry_id BIGSERIAL NOT NULL,
+    guild_id TEXT NOT NULL,
+    member_id TEXT NOT NULL,
+    grant_type TEXT NOT NULL CHECK (grant_type IN ('chat', 'voice')),
+    source TEXT NOT NULL CHECK (source IN ('grant', 'adjustment', 'migration', 'reset', 'compacted')),
+    amount INT NOT NULL,
+    channel_id TEXT NOT NULL DEFAULT '',
+    reference TEXT NOT NULL DEFAULT '',
+
+    PRIMARY KEY (entry_id)
+);
+
+CREATE INDEX IF NOT EXISTS guild_activity_ledger_member_index
+ON guild_activity_ledger (guild_id, member_id, entry_id DESC);
+
+CREATE INDEX IF NOT EXISTS guild_activity_ledger_insert_epoch_index
+ON guild_activity_ledger (insert_epoch);
+
+-- Existing points are carried over as compacted entries, so a member's ledger always adds up to their points.
+INSERT INTO guild_activity_ledger (guild_id, member_id, grant_type, source, amount)
+SELECT guild_id, member_id, 'chat', 'compacted', chat_activity
+FROM guild_profiles
+WHERE chat_activity <> 0;
+
+INSERT INTO guild_activity_ledger (guild_id, member_id, grant_type, source, amount)
+SELECT guild_id, member_id, 'voice', 'compacted', voice_activity
+FROM guild_profiles
+WHERE voice_activity <> 0;
@@ -0,0 +1,5 @@
+ALTER TABLE guilds
+DROP COLUMN IF EXISTS audit_log_channel_id;
+
+DROP INDEX IF EXISTS guild_settings_audit_log_guild_index;
+DROP TABLE IF EXISTS guild_settings_audit_log;
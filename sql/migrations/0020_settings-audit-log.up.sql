@@ -0,0 +1,24 @@
+-- Every change made to a guild's settings, so it's possible to tell who changed a setting and when.
+--
+-- `action` is what was changed, i.e. "activity_role.create", and `target` is which one was changed, i.e. the role ID or activity type.
+-- `before` and `after` only hold the fields that changed, `before` is empty for creations and `after` is empty for deletions.
+-- `actor_id` is the user that made the change, this is empty when the request didn't say who it was made by.
+CREATE TABLE IF NOT EXISTS guild_settings_audit_log (
+    insert_epoch INT NOT NULL DEFAULT EXTRACT (EPOCH FROM now() AT TIME ZONE 'utc'),
+    entry_id BIGSERIAL NOT NULL,
+    guild_id TEXT NOT NULL REFERENCES guilds (guild_id) ON DELETE CASCADE,
+    actor_id TEXT NOT NULL DEFAULT '',
+    action TEXT NOT NULL,
+    target TEXT NOT NULL DEFAULT '',
+    before JSONB NOT NULL DEFAULT '{}',
+    after JSONB NOT NULL DEFAULT '{}',
+
+    PRIMARY KEY (entry_id)
+);
+
+CREATE INDEX IF NOT EXISTS guild_settings_audit_log_guild_index
+ON guild_settings_audit_log (guild_id, entry_id DESC);
+
+-- The channel that settings changes are also posted to, this is empty when they aren't posted anywhere.
+ALTER TABLE guilds
+ADD COLUMN IF NOT EXISTS audit_log_channel_id TEXT NOT NULL DEFAULT '';
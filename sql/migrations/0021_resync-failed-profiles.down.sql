@@ -0,0 +1,2 @@
+ALTER TABLE guild_activity_role_resync_jobs
+DROP COLUMN IF EXISTS failed_profiles;
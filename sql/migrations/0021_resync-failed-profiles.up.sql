@@ -0,0 +1,3 @@
+-- Members whose roles couldn't be synced are counted instead of failing the whole job.
+ALTER TABLE guild_activity_role_resync_jobs
+ADD COLUMN IF NOT EXISTS failed_profiles INT NOT NULL DEFAULT 0;
@@ -0,0 +1,29 @@
+-- name: CreateAPIKey :one
+INSERT INTO api_keys (key_id, key_hash, name, scopes, expires_at)
+VALUES (@key_id, @key_hash, @name, @scopes::TEXT[], sqlc.narg(expires_at))
+RETURNING *;
+
+-- name: GetAPIKeyByHash :one
+SELECT * FROM api_keys
+WHERE
+    key_hash = @key_hash
+LIMIT 1;
+
+-- name: GetAPIKeys :many
+SELECT * FROM api_keys
+ORDER BY insert_epoch ASC;
+
+-- name: UpdateAPIKey :one
+UPDATE api_keys
+SET
+    name = COALESCE(sqlc.narg(name), name),
+    expires_at = COALESCE(sqlc.narg(expires_at), expires_at)
+WHERE
+    key_id = @key_id
+RETURNING *;
+
+-- name: DeleteAPIKey :one
+DELETE FROM api_keys
+WHERE
+    key_id = @key_id
+RETURNING key_hash;
@@ -0,0 +1,29 @@
+-- name: GetMemberActivityPointsForUpdate :one
+-- Locks the member's profile until the adjustment's transaction is finished.
+SELECT
+    chat_activity,
+    voice_activity
+FROM guild_profiles
+WHERE
+    guild_id = @guild_id
+    AND member_id = @member_id
+FOR UPDATE;
+
+-- name: SetMemberActivityPoints :exec
+-- The last grant is left alone, so adjustments don't affect the member's grant cooldown.
+UPDATE guild_profiles
+SET
+    chat_activity = CASE WHEN @grant_type::TEXT = 'chat' THEN @points::INT ELSE chat_activity END,
+    voice_activity = CASE WHEN @grant_type::TEXT = 'voice' THEN @points::INT ELSE voice_activity END
+WHERE
+    guild_id = @guild_id
+    AND member_id = @member_id;
+
+-- name: InsertActivityAdjustment :one
+INSERT INTO guild_activity_adjustments (
+    guild_id, member_id, grant_type, operation, amount, previous_points, new_points, adjusted_leaderboards, reason, actor_id
+)
+VALUES (
+    @guild_id, @member_id, @grant_type, @operation, @amount, @previous_points, @new_points, @adjusted_leaderboards, @reason, @actor_id
+)
+RETURNING *;
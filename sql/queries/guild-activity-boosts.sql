@@ -0,0 +1,64 @@
+-- name: CreateActivityBoost :one
+INSERT INTO guild_activity_boosts (
+    guild_id, name, grant_type, multiplier,
+    role_ids, starts_at, ends_at
+)
+VALUES (
+    @guild_id, @name, @grant_type, @multiplier,
+    @role_ids::TEXT[], @starts_at, @ends_at
+)
+RETURNING *;
+
+-- name: GetActivityBoosts :many
+-- Boosts that have ended are excluded, since they're cleaned up by the cron service.
+SELECT * FROM guild_activity_boosts
+WHERE
+    guild_id = @guild_id
+    AND ends_at > EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc')
+ORDER BY starts_at ASC, boost_id ASC;
+
+-- name: GetActivityBoost :one
+SELECT * FROM guild_activity_boosts
+WHERE
+    guild_id = @guild_id
+    AND boost_id = @boost_id;
+
+-- name: GetActiveActivityBoosts :many
+SELECT * FROM guild_activity_boosts
+WHERE
+    guild_id = @guild_id
+    AND grant_type = @grant_type
+    AND starts_at <= CAST(@at AS INT)
+    AND ends_at > CAST(@at AS INT);
+
+-- name: UpdateActivityBoost :one
+UPDATE guild_activity_boosts
+SET
+    name = COALESCE(sqlc.narg('name'), name),
+    multiplier = COALESCE(sqlc.narg('multiplier'), multiplier),
+    role_ids = COALESCE(sqlc.narg('role_ids')::TEXT[], role_ids),
+    starts_at = COALESCE(sqlc.narg('starts_at'), starts_at),
+    ends_at = COALESCE(sqlc.narg('ends_at'), ends_at)
+WHERE
+    guild_id = @guild_id
+    AND boost_id = @boost_id
+RETURNING *;
+
+-- name: DeleteActivityBoost :execrows
+DELETE FROM guild_activity_boosts
+WHERE
+    guild_id = @guild_id
+    AND boost_id = @boost_id;
+
+-- name: StartActivityBoosts :many
+UPDATE guild_activity_boosts
+SET has_started = TRUE
+WHERE
+    NOT has_started
+    AND starts_at <= EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc')
+RETURNING *;
+
+-- name: DeleteExpiredActivityBoosts :many
+DELETE FROM guild_activity_boosts
+WHERE ends_at <= EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc')
+RETURNING *;
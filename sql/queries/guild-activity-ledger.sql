@@ -0,0 +1,87 @@
+-- name: InsertActivityLedgerEntry :exec
+INSERT INTO guild_activity_ledger (guild_id, member_id, grant_type, source, amount, channel_id, reference)
+VALUES (@guild_id, @member_id, @grant_type, @source, @amount, @channel_id, @reference);
+
+-- name: BulkInsertChatActivityLedgerEntries :exec
+-- Records buffered chat grants, the arrays are matched up by their position.
+-- Grants for profiles that don't exist aren't applied, so they aren't recorded either.
+-- Each entry is the points a member earned in a single channel.
+INSERT INTO guild_activity_ledger (insert_epoch, guild_id, member_id, grant_type, source, amount, channel_id)
+SELECT
+    grants.granted_at,
+    grants.guild_id,
+    grants.member_id,
+    'chat',
+    'grant',
+    grants.points,
+    grants.channel_id
+FROM (
+    SELECT
+        UNNEST(@guild_ids::TEXT[]) AS guild_id,
+        UNNEST(@member_ids::TEXT[]) AS member_id,
+        UNNEST(@points::INT[]) AS points,
+        UNNEST(@granted_at::INT[]) AS granted_at,
+        UNNEST(@channel_ids::TEXT[]) AS channel_id
+) AS grants
+INNER JOIN guild_profiles
+    ON guild_profiles.guild_id = grants.guild_id
+    AND guild_profiles.member_id = grants.member_id;
+
+-- name: GetMemberActivityLedger :many
+SELECT *
+FROM guild_activity_ledger
+WHERE
+    guild_id = @guild_id
+    AND member_id = @member_id
+    AND (sqlc.narg(grant_type)::TEXT IS NULL OR grant_type = sqlc.narg(grant_type))
+ORDER BY entry_id DESC
+LIMIT @limit_by
+OFFSET @offset_by;
+
+-- name: CountMemberActivityLedger :one
+SELECT COUNT(*)::INT
+FROM guild_activity_ledger
+WHERE
+    guild_id = @guild_id
+    AND member_id = @member_id
+    AND (sqlc.narg(grant_type)::TEXT IS NULL OR grant_type = sqlc.narg(grant_type));
+
+-- name: CompactActivityLedger :execrows
+-- Replaces the entries older than the cutoff with a single compacted entry per member and activity type, which keeps their total.
+-- Only a batch of members is compacted at a time, the affected rows are the compacted entries that were inserted.
+WITH members AS (
+    SELECT DISTINCT
+        guild_id,
+        member_id,
+        grant_type
+    FROM guild_activity_ledger
+    WHERE
+        insert_epoch < @before::INT
+        AND source <> 'compacted'
+    LIMIT @batch_size::INT
+),
+removed AS (
+    DELETE FROM guild_activity_ledger AS ledger
+    USING members
+    WHERE
+        ledger.guild_id = members.guild_id
+        AND ledger.member_id = members.member_id
+        AND ledger.grant_type = members.grant_type
+        AND ledger.insert_epoch < @before::INT
+    RETURNING
+        ledger.insert_epoch,
+        ledger.guild_id,
+        ledger.member_id,
+        ledger.grant_type,
+        ledger.amount
+)
+INSERT INTO guild_activity_ledger (insert_epoch, guild_id, member_id, grant_type, source, amount)
+SELECT
+    MAX(insert_epoch),
+    guild_id,
+    member_id,
+    grant_type,
+    'compacted',
+    SUM(amount)::INT
+FROM removed
+GROUP BY guild_id, member_id, grant_type;
@@ -0,0 +1,74 @@
+-- name: CreateActivityRoleResyncJob :one
+INSERT INTO guild_activity_role_resync_jobs (guild_id, total_profiles)
+SELECT
+    @guild_id,
+    COUNT(*)
+FROM guild_profiles
+WHERE guild_profiles.guild_id = @guild_id
+RETURNING *;
+
+-- name: GetActivityRoleResyncJob :one
+SELECT * FROM guild_activity_role_resync_jobs
+WHERE
+    guild_id = @guild_id
+    AND job_id = @job_id;
+
+-- name: ClaimActivityRoleResyncJob :one
+-- Claims the oldest job that isn't finished or locked by another run.
+UPDATE guild_activity_role_resync_jobs
+SET
+    status = 'running',
+    started_at = COALESCE(started_at, EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc')),
+    locked_until = CAST(@locked_until AS INT)
+WHERE job_id = (
+    SELECT job_id
+    FROM guild_activity_role_resync_jobs AS jobs
+    WHERE
+        jobs.status IN ('pending', 'running')
+        AND (jobs.locked_until IS NULL OR jobs.locked_until < EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc'))
+    ORDER BY jobs.job_id ASC
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: GetActivityRoleResyncBatch :many
+SELECT
+    member_id,
+    chat_activity,
+    voice_activity
+FROM guild_profiles
+WHERE
+    guild_id = @guild_id
+    AND member_id > @after_member_id
+ORDER BY member_id ASC
+LIMIT @batch_size;
+
+-- name: UpdateActivityRoleResyncJobProgress :execrows
+-- Also renews the job's lock, so it isn't claimed by another run while this one is still processing it.
+-- Nothing is updated when the cursor has moved since the batch was read, which means another run has taken over the job.
+UPDATE guild_activity_role_resync_jobs
+SET
+    processed_profiles = processed_profiles + @processed,
+    failed_profiles = failed_profiles + @failed,
+    roles_added = roles_added + @roles_added,
+    roles_removed = roles_removed + @roles_removed,
+    last_member_id = @last_member_id,
+    locked_until = CAST(@locked_until AS INT)
+WHERE
+    job_id = @job_id
+    AND last_member_id = @previous_member_id;
+
+-- name: FinishActivityRoleResyncJob :exec
+UPDATE guild_activity_role_resync_jobs
+SET
+    status = @status,
+    error = @error,
+    finished_at = EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc'),
+    locked_until = NULL
+WHERE job_id = @job_id;
+
+-- name: ReleaseActivityRoleResyncJob :exec
+UPDATE guild_activity_role_resync_jobs
+SET locked_until = NULL
+WHERE job_id = @job_id;
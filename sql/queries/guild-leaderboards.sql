@@ -147,6 +147,54 @@ DO UPDATE SET
 WHERE
     guild_activity_tracking_monthly_current.grant_type = @grant_type;
 
+-- name: DeductWeeklyActivityLeaderboard :exec
+-- Removes points from the member's current weekly leaderboard entry, without going below 0.
+UPDATE guild_activity_tracking_weekly_current
+SET
+    earned_points = GREATEST(earned_points - @points::INT, 0)
+WHERE
+    grant_type = @grant_type
+    AND guild_id = @guild_id
+    AND member_id = @member_id;
+
+-- name: DeductMonthlyActivityLeaderboard :exec
+-- Removes points from the member's current monthly leaderboard entry, without going below 0.
+UPDATE guild_activity_tracking_monthly_current
+SET
+    earned_points = GREATEST(earned_points - @points::INT, 0)
+WHERE
+    grant_type = @grant_type
+    AND guild_id = @guild_id
+    AND member_id = @member_id;
+
+-- name: BulkIncrementWeeklyActivityLeaderboard :exec
+-- Applies aggregated grants to the current weekly leaderboard, the arrays are matched up by their position.
+INSERT INTO guild_activity_tracking_weekly_current (
+    grant_type, guild_id, member_id, earned_points
+)
+SELECT
+    @grant_type::TEXT,
+    UNNEST(@guild_ids::TEXT[]),
+    UNNEST(@member_ids::TEXT[]),
+    UNNEST(@earned_points::INT[])
+ON CONFLICT (grant_type, guild_id, member_id)
+DO UPDATE SET
+    earned_points = guild_activity_tracking_weekly_current.earned_points + EXCLUDED.earned_points;
+
+-- name: BulkIncrementMonthlyActivityLeaderboard :exec
+-- Applies aggregated grants to the current monthly leaderboard, the arrays are matched up by their position.
+INSERT INTO guild_activity_tracking_monthly_current (
+    grant_type, guild_id, member_id, earned_points
+)
+SELECT
+    @grant_type::TEXT,
+    UNNEST(@guild_ids::TEXT[]),
+    UNNEST(@member_ids::TEXT[]),
+    UNNEST(@earned_points::INT[])
+ON CONFLICT (grant_type, guild_id, member_id)
+DO UPDATE SET
+    earned_points = guild_activity_tracking_monthly_current.earned_points + EXCLUDED.earned_points;
+
 -- name: GetWeeklyActivityLeaderboardResetDetails :one
 SELECT
     (SELECT COUNT(*) FROM guild_activity_tracking_weekly_current) AS row_total,
@@ -225,4 +273,159 @@ WHEN NOT MATCHED THEN
     );
 
 -- name: FlushOudatedMonthlyActivityLeaderboard :exec
-TRUNCATE TABLE guild_activity_tracking_monthly_current;
\ No newline at end of file
+TRUNCATE TABLE guild_activity_tracking_monthly_current;
+
+-- name: GetArchivedWeeklyActivityLeaderboard :many
+SELECT
+    rankings.rank,
+    rankings.member_id,
+    rankings.earned_points
+FROM (
+    SELECT
+        ROW_NUMBER() OVER (
+            ORDER BY earned_points DESC
+        ) AS rank,
+        member_id,
+        earned_points
+    FROM guild_activity_tracking_weekly
+    WHERE
+        guild_id = @guild_id
+        AND grant_type = @grant_type
+        AND week_start = @week_start
+) AS rankings
+LIMIT @limit_by
+OFFSET @offset_by;
+
+-- name: GetArchivedWeeklyActivityLeaderboardPages :one
+SELECT
+    CAST(CEIL(COUNT(*)::DECIMAL / @limit_by) AS INT) AS total_pages
+FROM guild_activity_tracking_weekly
+WHERE
+    guild_id = @guild_id
+    AND grant_type = @grant_type
+    AND week_start = @week_start;
+
+-- name: GetArchivedMonthlyActivityLeaderboard :many
+SELECT
+    rankings.rank,
+    rankings.member_id,
+    rankings.earned_points
+FROM (
+    SELECT
+        ROW_NUMBER() OVER (
+            ORDER BY earned_points DESC
+        ) AS rank,
+        member_id,
+        earned_points
+    FROM guild_activity_tracking_monthly
+    WHERE
+        guild_id = @guild_id
+        AND grant_type = @grant_type
+        AND month_start = @month_start
+) AS rankings
+LIMIT @limit_by
+OFFSET @offset_by;
+
+-- name: GetArchivedMonthlyActivityLeaderboardPages :one
+SELECT
+    CAST(CEIL(COUNT(*)::DECIMAL / @limit_by) AS INT) AS total_pages
+FROM guild_activity_tracking_monthly
+WHERE
+    guild_id = @guild_id
+    AND grant_type = @grant_type
+    AND month_start = @month_start;
+
+-- name: GetArchivedWeeklyActivityLeaderboardPeriods :many
+SELECT
+    week_start::INT AS period_start,
+    COUNT(*)::INT AS total_members
+FROM guild_activity_tracking_weekly
+WHERE
+    guild_id = @guild_id
+    AND grant_type = @grant_type
+GROUP BY week_start
+ORDER BY week_start DESC;
+
+-- name: GetArchivedMonthlyActivityLeaderboardPeriods :many
+SELECT
+    month_start::INT AS period_start,
+    COUNT(*)::INT AS total_members
+FROM guild_activity_tracking_monthly
+WHERE
+    guild_id = @guild_id
+    AND grant_type = @grant_type
+GROUP BY month_start
+ORDER BY month_start DESC;
+
+-- name: GetMemberWeeklyActivityHistory :many
+SELECT
+    rankings.week_start,
+    rankings.earned_points,
+    rankings.rank
+FROM (
+    SELECT
+        week_start,
+        member_id,
+        earned_points,
+        ROW_NUMBER() OVER (PARTITION BY week_start ORDER BY earned_points DESC)::INT AS rank
+    FROM guild_activity_tracking_weekly
+    WHERE
+        guild_id = @guild_id
+        AND grant_type = @grant_type
+        AND week_start >= @since::INT
+) AS rankings
+WHERE
+    member_id = @member_id
+ORDER BY rankings.week_start DESC;
+
+-- name: GetMemberMonthlyActivityHistory :many
+SELECT
+    rankings.month_start,
+    rankings.earned_points,
+    rankings.rank
+FROM (
+    SELECT
+        month_start,
+        member_id,
+        earned_points,
+        ROW_NUMBER() OVER (PARTITION BY month_start ORDER BY earned_points DESC)::INT AS rank
+    FROM guild_activity_tracking_monthly
+    WHERE
+        guild_id = @guild_id
+        AND grant_type = @grant_type
+        AND month_start >= @since::INT
+) AS rankings
+WHERE
+    member_id = @member_id
+ORDER BY rankings.month_start DESC;
+
+-- name: GetMemberCurrentActivityPeriods :one
+WITH
+    weekly_leaderboard AS (
+        SELECT
+            member_id,
+            earned_points,
+            ROW_NUMBER() OVER (ORDER BY earned_points DESC)::INT AS rank
+        FROM guild_activity_tracking_weekly_current
+        WHERE
+            guild_activity_tracking_weekly_current.guild_id = @guild_id
+            AND guild_activity_tracking_weekly_current.grant_type = @grant_type
+    ),
+    monthly_leaderboard AS (
+        SELECT
+            member_id,
+            earned_points,
+            ROW_NUMBER() OVER (ORDER BY earned_points DESC)::INT AS rank
+        FROM guild_activity_tracking_monthly_current
+        WHERE
+            guild_activity_tracking_monthly_current.guild_id = @guild_id
+            AND guild_activity_tracking_monthly_current.grant_type = @grant_type
+    )
+SELECT
+    COALESCE(weekly_leaderboard.earned_points, 0)::INT AS weekly_earned_points,
+    COALESCE(weekly_leaderboard.rank, 0)::INT AS weekly_rank,
+    COALESCE(monthly_leaderboard.earned_points, 0)::INT AS monthly_earned_points,
+    COALESCE(monthly_leaderboard.rank, 0)::INT AS monthly_rank
+FROM (SELECT @member_id::TEXT AS member_id) m
+LEFT JOIN weekly_leaderboard ON weekly_leaderboard.member_id = m.member_id
+LEFT JOIN monthly_leaderboard ON monthly_leaderboard.member_id = m.member_id;
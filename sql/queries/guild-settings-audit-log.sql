@@ -0,0 +1,28 @@
+-- name: InsertSettingsAuditLogEntry :one
+INSERT INTO guild_settings_audit_log (guild_id, actor_id, action, target, before, after)
+VALUES (@guild_id, @actor_id, @action, @target, @before, @after)
+RETURNING *;
+
+-- name: GetSettingsAuditLog :many
+SELECT *
+FROM guild_settings_audit_log
+WHERE guild_id = @guild_id
+ORDER BY entry_id DESC
+LIMIT @limit_by
+OFFSET @offset_by;
+
+-- name: CountSettingsAuditLog :one
+SELECT COUNT(*)::INT
+FROM guild_settings_audit_log
+WHERE guild_id = @guild_id;
+
+-- name: GetGuildAuditLogChannel :one
+SELECT audit_log_channel_id
+FROM guilds
+WHERE guild_id = @guild_id;
+
+-- name: UpdateGuildAuditLogChannel :one
+UPDATE guilds
+SET audit_log_channel_id = @audit_log_channel_id
+WHERE guild_id = @guild_id
+RETURNING audit_log_channel_id;
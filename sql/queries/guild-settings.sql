@@ -8,7 +8,10 @@ SELECT
     is_enabled,
     grant_amount,
     grant_cooldown,
-    deny_roles
+    deny_roles,
+    auto_assign_roles,
+    role_assignment_mode,
+    auto_create_profiles
 FROM guild_chat_activity_settings
 WHERE
     guild_chat_activity_settings.guild_id = @guild_id
@@ -19,7 +22,10 @@ SELECT
     is_enabled,
     grant_amount,
     grant_cooldown,
-    deny_roles
+    deny_roles,
+    auto_assign_roles,
+    role_assignment_mode,
+    auto_create_profiles
 FROM guild_voice_activity_settings
 WHERE
     guild_voice_activity_settings.guild_id = @guild_id
@@ -116,7 +122,10 @@ WHERE
 UPDATE guild_chat_activity_settings SET
     is_enabled = COALESCE(sqlc.narg(is_enabled), guild_chat_activity_settings.is_enabled),
     grant_amount = COALESCE(sqlc.narg(grant_amount), guild_chat_activity_settings.grant_amount),
-    grant_cooldown = COALESCE(sqlc.narg(grant_cooldown), guild_chat_activity_settings.grant_cooldown)
+    grant_cooldown = COALESCE(sqlc.narg(grant_cooldown), guild_chat_activity_settings.grant_cooldown),
+    auto_assign_roles = COALESCE(sqlc.narg(auto_assign_roles), guild_chat_activity_settings.auto_assign_roles),
+    role_assignment_mode = COALESCE(sqlc.narg(role_assignment_mode), guild_chat_activity_settings.role_assignment_mode),
+    auto_create_profiles = COALESCE(sqlc.narg(auto_create_profiles), guild_chat_activity_settings.auto_create_profiles)
 WHERE
     guild_id = @guild_id;
 
@@ -124,7 +133,10 @@ WHERE
 UPDATE guild_voice_activity_settings SET
     is_enabled = COALESCE(sqlc.narg(is_enabled), guild_voice_activity_settings.is_enabled),
     grant_amount = COALESCE(sqlc.narg(grant_amount), guild_voice_activity_settings.grant_amount),
-    grant_cooldown = COALESCE(sqlc.narg(grant_cooldown), guild_voice_activity_settings.grant_cooldown)
+    grant_cooldown = COALESCE(sqlc.narg(grant_cooldown), guild_voice_activity_settings.grant_cooldown),
+    auto_assign_roles = COALESCE(sqlc.narg(auto_assign_roles), guild_voice_activity_settings.auto_assign_roles),
+    role_assignment_mode = COALESCE(sqlc.narg(role_assignment_mode), guild_voice_activity_settings.role_assignment_mode),
+    auto_create_profiles = COALESCE(sqlc.narg(auto_create_profiles), guild_voice_activity_settings.auto_create_profiles)
 WHERE
     guild_id = @guild_id;
 
@@ -132,8 +144,110 @@ WHERE
 INSERT INTO guild_activity_roles (guild_id, grant_type, role_id, required_points)
     VALUES (@guild_id, @grant_type, @role_id, @required_points::INT);
 
--- name: DeleteActivityRole :exec
+-- name: GetActivityRole :one
+SELECT
+    role_id,
+    grant_type,
+    required_points
+FROM guild_activity_roles
+WHERE
+    guild_id = @guild_id
+    AND role_id = @role_id;
+
+-- name: UpdateActivityRole :one
+UPDATE guild_activity_roles
+SET
+    grant_type = COALESCE(sqlc.narg('grant_type'), grant_type),
+    required_points = COALESCE(sqlc.narg('required_points'), required_points)
+WHERE
+    guild_id = @guild_id
+    AND role_id = @role_id
+RETURNING
+    role_id,
+    grant_type,
+    required_points;
+
+-- name: ActivityRoleThresholdExists :one
+-- Checks if another activity role of the same grant type already uses the threshold.
+SELECT EXISTS (
+    SELECT 1
+    FROM guild_activity_roles
+    WHERE
+        guild_id = @guild_id
+        AND grant_type = @grant_type
+        AND required_points = @required_points::INT
+        AND role_id <> @role_id
+);
+
+-- name: DeleteActivityRole :execrows
 DELETE FROM guild_activity_roles
 WHERE
     guild_id = @guild_id
-    AND role_id = @role_id;
\ No newline at end of file
+    AND role_id = @role_id;
+
+-- name: AppendGuildChatActivityDenyRole :one
+UPDATE guild_chat_activity_settings SET
+    deny_roles = ARRAY(
+        SELECT DISTINCT v
+        FROM UNNEST(ARRAY_APPEND(guild_chat_activity_settings.deny_roles, @role_id::TEXT)) AS v
+    )
+WHERE
+    guild_id = @guild_id
+RETURNING deny_roles;
+
+-- name: RemoveGuildChatActivityDenyRole :one
+UPDATE guild_chat_activity_settings SET
+    deny_roles = ARRAY_REMOVE(guild_chat_activity_settings.deny_roles, @role_id::TEXT)
+WHERE
+    guild_id = @guild_id
+RETURNING deny_roles;
+
+-- name: AppendGuildVoiceActivityDenyRole :one
+UPDATE guild_voice_activity_settings SET
+    deny_roles = ARRAY(
+        SELECT DISTINCT v
+        FROM UNNEST(ARRAY_APPEND(guild_voice_activity_settings.deny_roles, @role_id::TEXT)) AS v
+    )
+WHERE
+    guild_id = @guild_id
+RETURNING deny_roles;
+
+-- name: RemoveGuildVoiceActivityDenyRole :one
+UPDATE guild_voice_activity_settings SET
+    deny_roles = ARRAY_REMOVE(guild_voice_activity_settings.deny_roles, @role_id::TEXT)
+WHERE
+    guild_id = @guild_id
+RETURNING deny_roles;
+
+-- name: GetChatActivityChannelMultipliers :many
+SELECT
+    channel_id,
+    multiplier
+FROM guild_chat_activity_channel_multipliers
+WHERE
+    guild_id = @guild_id
+ORDER BY channel_id ASC;
+
+-- name: UpsertChatActivityChannelMultiplier :one
+INSERT INTO guild_chat_activity_channel_multipliers (guild_id, channel_id, multiplier)
+VALUES (@guild_id, @channel_id, @multiplier)
+ON CONFLICT (guild_id, channel_id)
+DO UPDATE SET
+    multiplier = EXCLUDED.multiplier
+RETURNING channel_id, multiplier;
+
+-- name: DeleteChatActivityChannelMultiplier :execrows
+DELETE FROM guild_chat_activity_channel_multipliers
+WHERE
+    guild_id = @guild_id
+    AND channel_id = @channel_id;
+
+-- name: ResolveChatActivityChannelMultiplier :one
+-- The channel IDs should be ordered by priority, for example: the channel, its parent channel and then its category.
+SELECT multiplier
+FROM guild_chat_activity_channel_multipliers
+WHERE
+    guild_id = @guild_id
+    AND channel_id = ANY(@channel_ids::TEXT[])
+ORDER BY ARRAY_POSITION(@channel_ids::TEXT[], channel_id) ASC
+LIMIT 1;
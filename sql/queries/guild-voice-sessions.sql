@@ -0,0 +1,56 @@
+-- name: OpenVoiceSession :one
+INSERT INTO guild_voice_sessions (
+    guild_id, member_id, channel_id,
+    is_muted, is_deafened, is_alone
+)
+VALUES (
+    @guild_id, @member_id, @channel_id,
+    @is_muted, @is_deafened, @is_alone
+)
+RETURNING *;
+
+-- name: GetVoiceSession :one
+SELECT * FROM guild_voice_sessions
+WHERE
+    guild_id = @guild_id
+    AND member_id = @member_id;
+
+-- name: UpdateVoiceSession :one
+-- The time since the last update is only accrued if the member was eligible during it.
+UPDATE guild_voice_sessions
+SET
+    active_seconds = active_seconds + CASE
+        WHEN is_muted OR is_deafened OR is_alone THEN 0
+        ELSE GREATEST(EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc')::INT - last_update, 0)
+    END,
+    last_update = EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc'),
+    channel_id = @channel_id,
+    is_muted = @is_muted,
+    is_deafened = @is_deafened,
+    is_alone = @is_alone
+WHERE
+    guild_id = @guild_id
+    AND member_id = @member_id
+RETURNING *;
+
+-- name: CloseVoiceSession :one
+-- The session is only accrued up until `closed_at`.
+-- Orphaned sessions are closed at their last update, since anything after it can't be verified.
+DELETE FROM guild_voice_sessions
+WHERE
+    guild_id = @guild_id
+    AND member_id = @member_id
+RETURNING
+    guild_id,
+    member_id,
+    channel_id,
+    started_at,
+    CAST(active_seconds + CASE
+        WHEN is_muted OR is_deafened OR is_alone THEN 0
+        ELSE GREATEST(CAST(@closed_at AS INT) - last_update, 0)
+    END AS INT) AS active_seconds;
+
+-- name: GetStaleVoiceSessions :many
+SELECT * FROM guild_voice_sessions
+WHERE last_update < CAST(@before AS INT)
+ORDER BY last_update ASC;
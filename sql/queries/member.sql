@@ -3,6 +3,12 @@ INSERT INTO guild_profiles (guild_id, member_id)
     VALUES (@guild_id, @member_id)
 RETURNING *;
 
+-- name: EnsureMemberProfile :execrows
+-- Creates the member's profile when it doesn't exist yet, a row is only affected when it was created.
+INSERT INTO guild_profiles (guild_id, member_id)
+    VALUES (@guild_id, @member_id)
+ON CONFLICT (guild_id, member_id) DO NOTHING;
+
 -- name: GetMemberProfile :one
 WITH profiles AS (
     SELECT
@@ -28,6 +34,8 @@ WHERE
     member_id = @member_id;
 
 -- name: IncrememberMemberChatActivityPoints :one
+-- The points are only granted once the cooldown has passed since the last grant.
+-- Concurrent grants wait on the row lock and re-check the cooldown, so only one of them is applied and the rest return no rows.
 UPDATE guild_profiles
 SET
     chat_activity = chat_activity + @points,
@@ -35,16 +43,48 @@ SET
 WHERE
     guild_id = @guild_id
     AND member_id = @member_id
+    AND last_chat_activity_grant <= EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc') - @cooldown::INT
+RETURNING *;
+
+-- name: BulkIncrementMemberChatActivityPoints :exec
+-- Applies aggregated chat grants to many profiles at once, the arrays are matched up by their position.
+UPDATE guild_profiles
+SET
+    chat_activity = guild_profiles.chat_activity + grants.points,
+    last_chat_activity_grant = GREATEST(guild_profiles.last_chat_activity_grant, grants.granted_at)
+FROM (
+    SELECT
+        UNNEST(@guild_ids::TEXT[]) AS guild_id,
+        UNNEST(@member_ids::TEXT[]) AS member_id,
+        UNNEST(@points::INT[]) AS points,
+        UNNEST(@granted_at::INT[]) AS granted_at
+) AS grants
+WHERE
+    guild_profiles.guild_id = grants.guild_id
+    AND guild_profiles.member_id = grants.member_id;
+
+-- name: IncrementMemberVoiceActivityPoints :one
+-- The cooldown works the same way as chat activity grants, a cooldown of 0 always grants the points.
+UPDATE guild_profiles
+SET
+    voice_activity = voice_activity + @points,
+    last_voice_activity_grant = EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc')
+WHERE
+    guild_id = @guild_id
+    AND member_id = @member_id
+    AND last_voice_activity_grant <= EXTRACT(EPOCH FROM now() AT TIME ZONE 'utc') - @cooldown::INT
 RETURNING *;
 
--- name: GetMemberChatActivityRoleInfo :one
+-- name: GetMemberActivityRoleInfo :one
 WITH
     activity_roles AS (
         SELECT
             role_id,
             required_points
         FROM guild_activity_roles
-        WHERE guild_activity_roles.guild_id = @guild_id
+        WHERE
+            guild_activity_roles.guild_id = @guild_id
+            AND guild_activity_roles.grant_type = @grant_type
     ),
     all_role_ids AS (
         SELECT CAST(ARRAY_AGG(role_id) AS TEXT[]) AS role_ids